@@ -1,17 +1,25 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	_ "time/tzdata"
 
 	_ "github.com/murathanje/birthday_tracking_backend/docs"
 	"github.com/murathanje/birthday_tracking_backend/internal/config"
 	"github.com/murathanje/birthday_tracking_backend/internal/handler"
 	"github.com/murathanje/birthday_tracking_backend/internal/middleware"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
 	"github.com/murathanje/birthday_tracking_backend/internal/repository"
 	"github.com/murathanje/birthday_tracking_backend/internal/service"
+	"github.com/murathanje/birthday_tracking_backend/internal/storage"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -26,9 +34,17 @@ import (
 // @description     Features:
 // @description     - User management with JWT authentication for user operations
 // @description     - API Key authentication for admin operations
-// @description     - Birthday tracking with simple categorization (string-based)
+// @description     - Birthday tracking with per-user categories (name, color, icon, sort order)
 // @description     - Example categories: "Family", "Friend", "Work", "School", etc.
-// @description     - Upcoming birthdays tracking
+// @description     - Multi-label tagging of birthdays (e.g. "college", "book-club", "vip")
+// @description     - Upcoming birthdays tracking, optionally grouped by household
+// @description     - Typed, bidirectional relationships between birthdays and households with their own address
+// @description     - Gift ideas per birthday, tracked from idea to given
+// @description     - Gift budgets per year, category or person, with a planned versus actual spending report
+// @description     - Interaction journal per birthday and a timeline merging it with the birthday's history
+// @description     - Celebration planning with a task checklist, a guest list and public RSVP links
+// @description     - Other yearly events besides birthdays: wedding and work anniversaries, anniversaries, memorials and name days,
+// @description       each with its own icon and wording of the years (e.g. "years married"); upcoming lists, gifts, the calendar feed and exports cover every type
 // @description
 // @description     Authentication:
 // @description     1. For Users:
@@ -48,6 +64,10 @@ import (
 // @description        - GET /api/v1/users/me - Get own profile
 // @description        - PUT /api/v1/users/me - Update own profile
 // @description        - DELETE /api/v1/users/me - Delete own account
+// @description        - POST /api/v1/users/me/export - Request a ZIP archive of all own data (built asynchronously)
+// @description        - GET /api/v1/users/me/export - List own data exports
+// @description        - GET /api/v1/users/me/export/{id} - Get export status and time-limited download link
+// @description        - GET /api/v1/exports/{id}/download - Download export archive (authenticated by signed link)
 // @description     3. Admin Endpoints (Requires API Key):
 // @description        - GET /api/v1/admin/users - List all users
 // @description        - GET /api/v1/admin/users/{id} - Get any user
@@ -55,14 +75,114 @@ import (
 // @description        - DELETE /api/v1/admin/users/{id} - Delete any user
 // @description     4. Birthday Endpoints (Requires JWT):
 // @description        - POST /api/v1/birthdays - Create birthday (with category as string)
-// @description        - GET /api/v1/birthdays - List own birthdays
+// @description          birth_date accepts "YYYY-MM-DD" or "MM-DD"; age fields are returned when the year is known
+// @description          event_type defaults to birthday; other types are created through POST /api/v1/events
+// @description        - POST /api/v1/birthdays/bulk - Create, update and delete birthdays in one transaction
+// @description          bulk creates and imports under /birthdays accept birthdays only
+// @description        - POST /api/v1/birthdays/import.csv - Import birthdays from CSV (column mapping, dry run, duplicate policy)
+// @description        - POST /api/v1/birthdays/import.vcf - Import birthdays from vCard contacts (BDAY, FN, CATEGORIES)
+// @description        - POST /api/v1/birthdays/import.ics - Import yearly events from an iCalendar file (idempotent by UID)
+// @description        - GET /api/v1/birthdays/export.csv - Export own birthdays as CSV
+// @description        - GET /api/v1/birthdays - List own birthdays (filterable, searchable, sortable, cursor-paginated)
+// @description        - GET /api/v1/birthdays/upcoming?days=N - List birthdays in the next N days (group_by=household to group them)
+// @description        - GET /api/v1/birthdays/categories - List categories with counts and next birthday
+// @description        - GET /api/v1/birthdays/duplicates - Find likely duplicates (same date, similar name)
+// @description        - POST /api/v1/birthdays/merge - Merge duplicates into one birthday
 // @description        - GET /api/v1/birthdays/{id} - Get specific birthday
+// @description        - GET /api/v1/birthdays/{id}/observances - Get observance dates per year (leap-day policy aware)
 // @description        - PUT /api/v1/birthdays/{id} - Update birthday
-// @description        - DELETE /api/v1/birthdays/{id} - Delete birthday
+// @description        - PATCH /api/v1/birthdays/{id} - Partially update birthday (JSON merge patch)
+// @description        - DELETE /api/v1/birthdays/{id} - Move birthday to the trash
+// @description        - GET /api/v1/birthdays/trash - List deleted birthdays
+// @description        - POST /api/v1/birthdays/{id}/restore - Restore deleted birthday
+// @description        - GET /api/v1/birthdays/{id}/history - List revisions with field-level diffs
+// @description        - POST /api/v1/birthdays/{id}/revert/{revision} - Revert birthday to an earlier revision
+// @description        - PUT /api/v1/birthdays/{id}/photo - Upload photo (JPEG, PNG or GIF)
+// @description        - GET /api/v1/birthdays/{id}/photo - Download photo
+// @description        - GET /api/v1/birthdays/{id}/photo/thumbnail - Download photo thumbnail
+// @description        - DELETE /api/v1/birthdays/{id}/photo - Delete photo
+// @description        - POST /api/v1/birthdays/{id}/tags - Add tags to birthday
+// @description        - DELETE /api/v1/birthdays/{id}/tags/{tag} - Remove tag from birthday
+// @description        - GET /api/v1/birthdays/{id}/relationships - List relatives
+// @description        - POST /api/v1/birthdays/{id}/relationships - Relate to another birthday (inverse added automatically)
+// @description        - DELETE /api/v1/birthdays/{id}/relationships/{relationship} - Remove relationship in both directions
+// @description        - GET /api/v1/birthdays/{id}/gifts - List gifts (status=open for those not given yet)
+// @description        - POST /api/v1/birthdays/{id}/gifts - Add gift idea
+// @description        - GET /api/v1/birthdays/{id}/gifts/{gift} - Get gift
+// @description        - PUT /api/v1/birthdays/{id}/gifts/{gift} - Update gift (status only moves forward: idea, purchased, wrapped, given)
+// @description        - DELETE /api/v1/birthdays/{id}/gifts/{gift} - Delete gift
+// @description        - GET /api/v1/birthdays/{id}/interactions - List journal entries, oldest first
+// @description        - POST /api/v1/birthdays/{id}/interactions - Add journal entry (called, met, sent_card, gift_given, other)
+// @description        - GET /api/v1/birthdays/{id}/interactions/{interaction} - Get journal entry
+// @description        - PUT /api/v1/birthdays/{id}/interactions/{interaction} - Update journal entry
+// @description        - DELETE /api/v1/birthdays/{id}/interactions/{interaction} - Delete journal entry
+// @description        - GET /api/v1/birthdays/{id}/timeline - Journal entries and system events in chronological order
+// @description        - GET /api/v1/birthdays/{id}/celebrations - List celebrations of a birthday
+// @description        - POST /api/v1/birthdays/{id}/celebrations - Plan celebration of the birthday in a year
+// @description     5. Event Endpoints (Requires JWT):
+// @description        The birthday list endpoints above only return birthdays; these return events of every type, or of the one given as type.
+// @description        Single events of any type are addressed through /api/v1/birthdays/{id}.
+// @description        - GET /api/v1/events/types - List event types with icon, count label and whether they involve two people
+// @description        - POST /api/v1/events - Create event of any type (event_type, partner_name for couples)
+// @description        - GET /api/v1/events?type=T - List events (same filters as GET /api/v1/birthdays)
+// @description        - GET /api/v1/events/upcoming?days=N&type=T - List events in the next N days
+// @description        - GET /api/v1/events/categories?type=T - List categories with event counts and next event
+// @description        - GET /api/v1/events/duplicates?type=T - Find likely duplicates among events of the same type
+// @description        - GET /api/v1/events/trash?type=T - List deleted events
+// @description        - GET /api/v1/events/export.csv?type=T - Export events as CSV
+// @description        - POST /api/v1/events/bulk, /import.csv, /import.vcf, /import.ics - Bulk operations and imports of any event type
+// @description     6. Category Endpoints (Requires JWT):
+// @description        - POST /api/v1/categories - Create category
+// @description        - GET /api/v1/categories - List own categories
+// @description        - GET /api/v1/categories/{id} - Get specific category
+// @description        - PUT /api/v1/categories/{id} - Update category (renames cascade to birthdays)
+// @description        - DELETE /api/v1/categories/{id} - Delete empty category
+// @description        - POST /api/v1/categories/{id}/merge - Merge other categories into this one
+// @description     7. Tag Endpoints (Requires JWT):
+// @description        - GET /api/v1/tags - List own tags with usage counts
+// @description        - DELETE /api/v1/tags/{id} - Delete tag
+// @description     8. Household Endpoints (Requires JWT):
+// @description        - POST /api/v1/households - Create household
+// @description        - GET /api/v1/households - List own households with member counts
+// @description        - GET /api/v1/households/{id} - Get specific household
+// @description        - PUT /api/v1/households/{id} - Update household name and address
+// @description        - DELETE /api/v1/households/{id} - Delete household (members are kept)
+// @description        - GET /api/v1/households/{id}/members - List members
+// @description        - PUT /api/v1/households/{id}/members/{birthday_id} - Move birthday into household
+// @description        - DELETE /api/v1/households/{id}/members/{birthday_id} - Take birthday out of household
+// @description     9. Gift Endpoints (Requires JWT):
+// @description        - GET /api/v1/gifts/upcoming?days=N - List open gift ideas for birthdays and other events in the next N days
+// @description     10. Budget Endpoints (Requires JWT):
+// @description        - POST /api/v1/budgets - Create budget for a year, a category or a birthday
+// @description        - GET /api/v1/budgets?year=YYYY - List budgets
+// @description        - GET /api/v1/budgets/{id} - Get budget
+// @description        - PUT /api/v1/budgets/{id} - Update budget
+// @description        - DELETE /api/v1/budgets/{id} - Delete budget
+// @description        - GET /api/v1/reports/spending?year=YYYY - Planned versus actual gift spending by month, category and person
+// @description     11. Celebration Endpoints (Requires JWT unless noted):
+// @description        - GET /api/v1/celebrations?upcoming=true - List celebrations, by date
+// @description        - GET /api/v1/celebrations/{id} - Get celebration with tasks, guests and RSVP links
+// @description        - PUT /api/v1/celebrations/{id} - Update celebration
+// @description        - DELETE /api/v1/celebrations/{id} - Delete celebration
+// @description        - POST /api/v1/celebrations/{id}/tasks - Add task
+// @description        - PUT /api/v1/celebrations/{id}/tasks/{task} - Update or check off task
+// @description        - DELETE /api/v1/celebrations/{id}/tasks/{task} - Delete task
+// @description        - POST /api/v1/celebrations/{id}/guests - Invite guest
+// @description        - PUT /api/v1/celebrations/{id}/guests/{guest} - Update guest
+// @description        - DELETE /api/v1/celebrations/{id}/guests/{guest} - Remove guest (revokes the RSVP link)
+// @description        - GET /api/v1/rsvp/{token} - Get invitation (authenticated by the guest's signed RSVP token)
+// @description        - PUT /api/v1/rsvp/{token} - Answer invitation (authenticated by the guest's signed RSVP token)
+// @description     12. Calendar Feed Endpoints:
+// @description        - POST /api/v1/feeds - Create secret calendar feed URL (Requires JWT)
+// @description        - GET /api/v1/feeds - Get feed settings (Requires JWT)
+// @description        - PUT /api/v1/feeds - Update feed reminder (Requires JWT)
+// @description        - POST /api/v1/feeds/rotate - Rotate feed token (Requires JWT)
+// @description        - DELETE /api/v1/feeds - Revoke feed (Requires JWT)
+// @description        - GET /api/v1/feeds/{token}/birthdays.ics - iCalendar feed (authenticated by token)
 // @description
 // @description     Birthday Categories:
-// @description     Categories are now implemented as simple strings. You can use any string value
-// @description     for categorization. Some suggested categories:
+// @description     Categories are per-user records. Birthdays reference a category by name, matched
+// @description     case-insensitively; unknown names create a new category. Some suggested categories:
 // @description     - "Family" - For family members
 // @description     - "Friend" - For friends
 // @description     - "Work" - For work colleagues
@@ -99,7 +219,37 @@ import (
 // @tag.description Admin endpoints for user management (requires API Key)
 
 // @tag.name birthdays
-// @tag.description Birthday management endpoints with string-based categorization (requires JWT authentication)
+// @tag.description Birthday management endpoints (requires JWT authentication)
+
+// @tag.name events
+// @tag.description Yearly recurring event types (requires JWT authentication)
+
+// @tag.name categories
+// @tag.description Birthday category management endpoints (requires JWT authentication)
+
+// @tag.name tags
+// @tag.description Tag management endpoints (requires JWT authentication)
+
+// @tag.name gifts
+// @tag.description Gift idea endpoints (requires JWT authentication)
+
+// @tag.name interactions
+// @tag.description Interaction journal and timeline endpoints (requires JWT authentication)
+
+// @tag.name celebrations
+// @tag.description Celebration planning endpoints (requires JWT authentication)
+
+// @tag.name rsvp
+// @tag.description Public RSVP endpoints for invited guests (authenticated by a signed token)
+
+// @tag.name budgets
+// @tag.description Gift budget endpoints (requires JWT authentication)
+
+// @tag.name reports
+// @tag.description Spending report endpoints (requires JWT authentication)
+
+// @tag.name feeds
+// @tag.description iCalendar subscription feed endpoints (management requires JWT authentication, the feed itself a secret token)
 
 // @schemes https
 
@@ -110,22 +260,79 @@ func main() {
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s sslmode=require",
 		cfg.DBHost, cfg.DBUser, cfg.DBPassword, cfg.DBName)
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	// Unique violations are reported as gorm.ErrDuplicatedKey.
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
+	if err := db.AutoMigrate(&models.User{}, &models.Category{}, &models.Tag{}, &models.Household{}, &models.Birthday{}, &models.BirthdayPhoto{}, &models.BirthdayRelationship{}, &models.BirthdayRevision{}, &models.Gift{}, &models.Interaction{}, &models.Celebration{}, &models.CelebrationTask{}, &models.CelebrationGuest{}, &models.Budget{}, &models.CalendarFeed{}, &models.DataExport{}); err != nil {
+		log.Fatalf("Failed to migrate database: %v", err)
+	}
+
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(db)
 	birthdayRepo := repository.NewBirthdayRepository(db)
+	revisionRepo := repository.NewRevisionRepository(db)
+	photoRepo := repository.NewPhotoRepository(db)
+	relationshipRepo := repository.NewRelationshipRepository(db)
+	householdRepo := repository.NewHouseholdRepository(db)
+	giftRepo := repository.NewGiftRepository(db)
+	budgetRepo := repository.NewBudgetRepository(db)
+	interactionRepo := repository.NewInteractionRepository(db)
+	celebrationRepo := repository.NewCelebrationRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	tagRepo := repository.NewTagRepository(db)
+	feedRepo := repository.NewFeedRepository(db)
+	exportRepo := repository.NewExportRepository(db)
+
+	if err := categoryRepo.MigrateLegacyCategories(); err != nil {
+		log.Fatalf("Failed to migrate legacy categories: %v", err)
+	}
+
+	photoStorage, err := newPhotoStorage(cfg)
+	if err != nil {
+		log.Fatalf("Failed to configure photo storage: %v", err)
+	}
 
 	// Initialize services
 	userService := service.NewUserService(userRepo, cfg)
-	birthdayService := service.NewBirthdayService(birthdayRepo)
+	categoryService := service.NewCategoryService(categoryRepo)
+	tagService := service.NewTagService(tagRepo)
+	birthdayService := service.NewBirthdayService(birthdayRepo, revisionRepo, relationshipRepo, categoryService, tagService, cfg)
+	feedService := service.NewFeedService(feedRepo, birthdayRepo)
+	photoService := service.NewPhotoService(photoRepo, photoStorage)
+	householdService := service.NewHouseholdService(householdRepo, birthdayService)
+	giftService := service.NewGiftService(giftRepo, birthdayService)
+	budgetService := service.NewBudgetService(budgetRepo, giftRepo, birthdayService, categoryService)
+	interactionService := service.NewInteractionService(interactionRepo, giftRepo, birthdayService)
+	celebrationService := service.NewCelebrationService(celebrationRepo, birthdayService, cfg)
+	exportService := service.NewExportService(exportRepo, userService, birthdayService, categoryService, tagService, feedService, photoService, householdService, giftService, budgetService, interactionService, celebrationService, cfg)
+
+	if err := exportService.FailInterrupted(); err != nil {
+		log.Fatalf("Failed to recover data exports: %v", err)
+	}
+
+	// The background jobs and the server stop on SIGINT or SIGTERM.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go exportService.RunJanitor(ctx, 10*time.Minute)
+	go birthdayService.RunPurge(ctx, time.Hour)
+	go photoService.RunJanitor(ctx, 10*time.Minute)
 
 	// Initialize handlers
 	userHandler := handler.NewUserHandler(userService, cfg)
-	birthdayHandler := handler.NewBirthdayHandler(birthdayService, userService)
+	birthdayHandler := handler.NewBirthdayHandler(birthdayService, photoService, householdService, userService)
+	categoryHandler := handler.NewCategoryHandler(categoryService, userService)
+	tagHandler := handler.NewTagHandler(tagService, userService)
+	householdHandler := handler.NewHouseholdHandler(householdService, userService)
+	giftHandler := handler.NewGiftHandler(giftService, birthdayService, userService)
+	budgetHandler := handler.NewBudgetHandler(budgetService, userService)
+	interactionHandler := handler.NewInteractionHandler(interactionService, birthdayService, userService)
+	celebrationHandler := handler.NewCelebrationHandler(celebrationService, birthdayService, userService, cfg)
+	feedHandler := handler.NewFeedHandler(feedService, userService, cfg)
+	exportHandler := handler.NewExportHandler(exportService, userService, cfg)
 
 	router := gin.New()
 	router.SetTrustedProxies([]string{"127.0.0.1"})
@@ -136,6 +343,15 @@ func main() {
 	// Register routes
 	userHandler.RegisterRoutes(router)
 	birthdayHandler.RegisterRoutes(router)
+	categoryHandler.RegisterRoutes(router)
+	tagHandler.RegisterRoutes(router)
+	householdHandler.RegisterRoutes(router)
+	giftHandler.RegisterRoutes(router)
+	budgetHandler.RegisterRoutes(router)
+	interactionHandler.RegisterRoutes(router)
+	celebrationHandler.RegisterRoutes(router)
+	feedHandler.RegisterRoutes(router)
+	exportHandler.RegisterRoutes(router)
 
 	router.GET("/health", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{
@@ -146,9 +362,49 @@ func main() {
 
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	serverAddr := fmt.Sprintf(":%d", cfg.ServerPort)
-	log.Printf("Server starting on %s in %s mode", serverAddr, os.Getenv("GIN_MODE"))
-	if err := router.Run(serverAddr); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", cfg.ServerPort),
+		Handler: router,
+	}
+	go func() {
+		log.Printf("Server starting on %s in %s mode", server.Addr, os.Getenv("GIN_MODE"))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to start server: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+	stop()
+	log.Printf("Shutting down server")
+
+	// Let requests in flight finish before exiting.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Fatalf("Failed to shut down server: %v", err)
+	}
+
+	// Finish the data exports being built, so that they are not left in
+	// progress.
+	if err := exportService.Wait(shutdownCtx); err != nil {
+		log.Printf("Data exports still being built at shutdown: %v", err)
+	}
+}
+
+// newPhotoStorage returns the object storage selected by STORAGE_BACKEND
+func newPhotoStorage(cfg *config.Config) (storage.Storage, error) {
+	switch cfg.StorageBackend {
+	case "local":
+		return storage.NewLocal(cfg.StorageDir), nil
+	case "s3":
+		return storage.NewS3(storage.S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q, expected local or s3", cfg.StorageBackend)
 	}
 }
@@ -243,7 +243,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Get all birthdays for the authenticated user",
+                "description": "Get a page of birthdays for the authenticated user, optionally filtered and sorted.\nPass the returned next_cursor as the cursor parameter to fetch the following page.\nGET /birthdays lists birthdays only; GET /events lists events of every type, or of the given type.",
                 "produces": [
                     "application/json"
                 ],
@@ -251,13 +251,108 @@ const docTemplate = `{
                     "birthdays"
                 ],
                 "summary": "Get user's birthdays",
+                "parameters": [
+                    {
+                        "enum": [
+                            "birthday",
+                            "wedding_anniversary",
+                            "anniversary",
+                            "work_anniversary",
+                            "memorial",
+                            "name_day",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Filter by event type (GET /events only)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by category (case-insensitive)",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by birth month (1-12)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name prefix (case-insensitive)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search name, notes, phone numbers, emails, addresses and social handles (case-insensitive substring; phone numbers match regardless of formatting)",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by tags (comma-separated or repeated)",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "Whether birthdays must carry any or all of the tags",
+                        "name": "tag_mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "next",
+                            "name",
+                            "-name",
+                            "created_at",
+                            "-created_at"
+                        ],
+                        "type": "string",
+                        "default": "next",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Page size (1-100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
@@ -287,7 +382,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new birthday record for the authenticated user",
+                "description": "Create a new birthday record for the authenticated user.\nPOST /events creates events of any type given in event_type (default birthday); POST /birthdays only creates birthdays.",
                 "consumes": [
                     "application/json"
                 ],
@@ -297,7 +392,7 @@ const docTemplate = `{
                 "tags": [
                     "birthdays"
                 ],
-                "summary": "Create a new birthday",
+                "summary": "Create a new birthday or other event",
                 "parameters": [
                     {
                         "description": "Birthday details",
@@ -346,39 +441,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/birthdays/{id}": {
-            "get": {
+        "/birthdays/bulk": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get a birthday record by its ID (must belong to authenticated user)",
+                "description": "Run up to 500 create, update and delete operations in one database transaction.\nWith all_or_nothing=true any failure rolls back the whole batch (422); otherwise failed operations are skipped.\nEvery operation gets a result with its index, status and validation error.\nPOST /birthdays/bulk only creates birthdays (400 for creates of other event types); POST /events/bulk creates events of any type.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "birthdays"
                 ],
-                "summary": "Get a birthday by ID",
+                "summary": "Create, update and delete birthdays in bulk",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Birthday ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Operations",
+                        "name": "bulk",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BulkBirthdayRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Batch committed",
                         "schema": {
-                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BulkBirthdayResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Invalid request body",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -386,8 +486,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -395,8 +495,14 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not found",
+                    "422": {
+                        "description": "Batch rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BulkBirthdayResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -405,51 +511,52 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/birthdays/categories": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update a birthday record (must belong to authenticated user)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get every category of the authenticated user with its number of birthdays and the next upcoming birthday in it.\nUse GET /birthdays?category=X to list the birthdays of a single category.\nGET /events/categories counts events of every type, or of the given type.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "birthdays"
                 ],
-                "summary": "Update a birthday",
+                "summary": "Get birthday counts per category",
                 "parameters": [
                     {
+                        "enum": [
+                            "birthday",
+                            "wedding_anniversary",
+                            "anniversary",
+                            "work_anniversary",
+                            "memorial",
+                            "name_day",
+                            "other"
+                        ],
                         "type": "string",
-                        "description": "Birthday ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Birthday details",
-                        "name": "birthday",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateBirthdayRequest"
-                        }
+                        "description": "Event type (GET /events/categories only)",
+                        "name": "type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CategoryStatsResponse"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Invalid type parameter",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -466,17 +573,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "404": {
-                        "description": "Not found",
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -485,42 +583,52 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/birthdays/duplicates": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a birthday record (must belong to authenticated user)",
+                "description": "Group the authenticated user's birthdays that probably belong to the same person: they fall on the same month and day\nand their names are similar once case, punctuation and word order are ignored (equal, one containing all words of the other, or differing by a few typos).\nGroups are ordered by date and their birthdays oldest first; merge a group with POST /birthdays/merge.\nGET /events/duplicates finds duplicates among events of every type, or of the given type; only events of the same type are grouped.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "birthdays"
                 ],
-                "summary": "Delete a birthday",
+                "summary": "Find likely duplicate birthdays",
                 "parameters": [
                     {
+                        "enum": [
+                            "birthday",
+                            "wedding_anniversary",
+                            "anniversary",
+                            "work_anniversary",
+                            "memorial",
+                            "name_day",
+                            "other"
+                        ],
                         "type": "string",
-                        "description": "Birthday ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Event type (GET /events/duplicates only)",
+                        "name": "type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.DuplicateGroupResponse"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Invalid type parameter",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -537,17 +645,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "404": {
-                        "description": "Not found",
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -558,39 +657,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/login": {
-            "post": {
-                "description": "Authenticate user and return JWT token for accessing protected endpoints\nThe returned token should be included in the Authorization header as \"Bearer \u003ctoken\u003e\"",
-                "consumes": [
-                    "application/json"
+        "/birthdays/export.csv": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
                 ],
+                "description": "Download all birthdays of the authenticated user as CSV with the columns name, birth_date, category, notes, event_type and partner_name, ordered by name.\nGET /events/export.csv exports events of every type, or of the given type.\nCells that spreadsheets would evaluate as formulas are prefixed with a single quote; the import removes it again.",
                 "produces": [
-                    "application/json"
+                    "text/csv"
                 ],
                 "tags": [
-                    "auth"
+                    "birthdays"
                 ],
-                "summary": "Login user",
+                "summary": "Export birthdays as CSV",
                 "parameters": [
                     {
-                        "description": "Login credentials",
-                        "name": "credentials",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.LoginRequest"
-                        }
+                        "enum": [
+                            "birthday",
+                            "wedding_anniversary",
+                            "anniversary",
+                            "work_anniversary",
+                            "memorial",
+                            "name_day",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Event type (GET /events/export.csv only)",
+                        "name": "type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully authenticated with JWT token",
+                        "description": "CSV file",
                         "schema": {
-                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.LoginResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "Invalid request format",
+                        "description": "Invalid type parameter",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -599,7 +706,16 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Invalid email or password",
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -610,39 +726,95 @@ const docTemplate = `{
                 }
             }
         },
-        "/register": {
+        "/birthdays/import.csv": {
             "post": {
-                "description": "Register a new user account with email and password\nAfter registration, use the /login endpoint to obtain a JWT token",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Import birthdays from a CSV file with a header row, using the same validation as creating a birthday.\nColumns default to name, birth_date, category and notes (matched case-insensitively) and can be mapped to other headers.\nExisting birthdays with the same name (case-insensitive) are skipped, updated or duplicated according to on_duplicate.\nWith dry_run=true nothing is saved and the report shows what would happen. Files are limited to 5 MB and 5000 rows.\nRows with an event_type other than birthday are reported as invalid on POST /birthdays/import.csv; POST /events/import.csv imports every type.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "birthdays"
                 ],
-                "summary": "Register a new user",
+                "summary": "Import birthdays from CSV",
                 "parameters": [
                     {
-                        "description": "User registration details",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateUserRequest"
-                        }
+                        "type": "file",
+                        "description": "CSV file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Validate and report without saving",
+                        "name": "dry_run",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "skip",
+                            "update",
+                            "create"
+                        ],
+                        "type": "string",
+                        "default": "skip",
+                        "description": "What to do with rows whose name matches an existing birthday",
+                        "name": "on_duplicate",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category for rows without one",
+                        "name": "default_category",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "name",
+                        "description": "Header of the name column",
+                        "name": "name_column",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "birth_date",
+                        "description": "Header of the birth date column",
+                        "name": "birth_date_column",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "category",
+                        "description": "Header of the category column",
+                        "name": "category_column",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "notes",
+                        "description": "Header of the notes column",
+                        "name": "notes_column",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "User successfully registered",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UserResponse"
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.ImportReport"
                         }
                     },
                     "400": {
-                        "description": "Invalid request format",
+                        "description": "Invalid file or options",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -650,8 +822,26 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "409": {
-                        "description": "Email already exists",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -662,30 +852,101 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
-            "get": {
+        "/birthdays/import.ics": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get the profile of the currently authenticated user",
+                "description": "Import yearly recurring events (RRULE:FREQ=YEARLY) from an .ics file exported by another calendar, using the same validation as creating a birthday.\nSUMMARY becomes the name (a trailing \"'s birthday\" is removed), DTSTART the birth date and DESCRIPTION the notes. The first CATEGORIES value becomes the category and the others become tags.\nEvents are remembered by UID, so importing the same file again skips them (or updates them with on_duplicate=update) instead of creating duplicates.\nEvents without a known UID are matched by name like the other imports; other events are skipped. Files are limited to 5 MB and 5000 events.\nAnniversaries, memorials and other events recognised from SUMMARY are reported as invalid on POST /birthdays/import.ics; POST /events/import.ics imports every type.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "birthdays"
                 ],
-                "summary": "Get current user's profile",
-                "responses": {
-                    "200": {
-                        "description": "User profile",
+                "summary": "Import birthdays from an iCalendar file",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "iCalendar file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Validate and report without saving",
+                        "name": "dry_run",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "skip",
+                            "update",
+                            "create"
+                        ],
+                        "type": "string",
+                        "default": "skip",
+                        "description": "What to do with events that were imported before or whose name matches an existing birthday",
+                        "name": "on_duplicate",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category for events without CATEGORIES",
+                        "name": "default_category",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Treat every birth year as unknown, for calendars that start the series when the event was created",
+                        "name": "ignore_year",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UserResponse"
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid file or options",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
-                        "description": "Missing or invalid JWT token",
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -694,14 +955,114 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/birthdays/import.vcf": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update the profile of the currently authenticated user",
+                "description": "Import birthdays from a vCard 3.0 or 4.0 file with one or more contacts, using the same validation as creating a birthday.\nFN (or N) becomes the name and BDAY the birth date (YYYYMMDD, YYYY-MM-DD, --MMDD or --MM-DD). The first CATEGORIES value becomes the category and the others become tags.\nContacts without BDAY are skipped. Existing birthdays with the same name (case-insensitive) are skipped, updated or duplicated according to on_duplicate.\nWith dry_run=true nothing is saved and the report shows what would happen. Files are limited to 5 MB and 5000 contacts.\nPOST /birthdays/import.vcf and POST /events/import.vcf behave the same, as contacts are always imported as birthdays.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Import birthdays from vCard contacts",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "vCard file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Validate and report without saving",
+                        "name": "dry_run",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "skip",
+                            "update",
+                            "create"
+                        ],
+                        "type": "string",
+                        "default": "skip",
+                        "description": "What to do with contacts whose name matches an existing birthday",
+                        "name": "on_duplicate",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category for contacts without CATEGORIES",
+                        "name": "default_category",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid file or options",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/merge": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Merge one or more birthdays into a survivor and move them to the trash, in one transaction. All of them must be events of the same type.\nName, birth date and category are taken from the birthday named in fields, defaulting to the survivor;\na survivor without a birth year takes it from a source on the same date unless birth_date is chosen.\nNotes are concatenated (survivor first, duplicates dropped) and tags are combined.",
                 "consumes": [
                     "application/json"
                 ],
@@ -709,29 +1070,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "birthdays"
                 ],
-                "summary": "Update current user's profile",
+                "summary": "Merge birthdays",
                 "parameters": [
                     {
-                        "description": "User update details",
-                        "name": "user",
+                        "description": "Birthdays to merge",
+                        "name": "merge",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UpdateUserRequest"
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.MergeBirthdaysRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated profile",
+                        "description": "The merged survivor",
                         "schema": {
-                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UserResponse"
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request format",
+                        "description": "Invalid request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -740,7 +1101,25 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Missing or invalid JWT token",
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "A birthday was not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -749,63 +1128,9618 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/birthdays/trash": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete the account of the currently authenticated user",
+                "description": "List the authenticated user's birthdays in the trash, most recently deleted first, with the time each one is permanently deleted.\nGET /events/trash lists deleted events of every type, or of the given type.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "birthdays"
+                ],
+                "summary": "List deleted birthdays",
+                "parameters": [
+                    {
+                        "enum": [
+                            "birthday",
+                            "wedding_anniversary",
+                            "anniversary",
+                            "work_anniversary",
+                            "memorial",
+                            "name_day",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Event type (GET /events/trash only)",
+                        "name": "type",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Delete current user's account",
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.TrashedBirthdayResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid type parameter",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    }
+                }
+            }
+        },
+        "/birthdays/upcoming": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the authenticated user's birthdays occurring within the next N days, ordered by next occurrence.\nDates are computed in the user's timezone, wrap from December to January and follow the user's leap-day policy.\nWith group_by=household the birthdays are grouped by household instead (response: array of models.UpcomingHouseholdResponse), groups ordered by their earliest birthday; birthdays without a household form one group with a null household_id.\nGET /events/upcoming includes events of every type, or of the given type.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Get upcoming birthdays",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Window size in days, including today (1-366)",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "household"
+                        ],
+                        "type": "string",
+                        "description": "Group the birthdays",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "birthday",
+                            "wedding_anniversary",
+                            "anniversary",
+                            "work_anniversary",
+                            "memorial",
+                            "name_day",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Event type (GET /events/upcoming only)",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UpcomingBirthdayResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid days, group_by or type parameter",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a birthday record by its ID (must belong to authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Get a birthday by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace a birthday record (must belong to authenticated user). Tags are only replaced when present.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Update a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Birthday details",
+                        "name": "birthday",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateBirthdayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Move a birthday record to the trash (must belong to authenticated user).\nIt can be restored until it is permanently deleted after the trash retention period.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Delete a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Apply an RFC 7386 JSON merge patch to a birthday record (must belong to authenticated user).\nOnly the fields present in the document are changed. null clears notes, partner_name, tags and contact detail lists;\nname, birth_date, event_type and category cannot be removed. tags, when present, replaces the full tag list.",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Partially update a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Merge patch document",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PatchBirthdayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported content type",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/celebrations": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the celebrations planned for a birthday (must belong to authenticated user), by date, with their tasks, guests and RSVP links",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Get the celebrations of a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Plan a celebration of one occurrence (year) of a birthday (must belong to authenticated user). The date defaults to the birthday in that year.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Plan a celebration of a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Celebration details",
+                        "name": "celebration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateCelebrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/gifts": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the gift ideas for a birthday (must belong to authenticated user). Gifts not given yet come first, by priority and then oldest first,\nfollowed by the gifts already given, most recently given first. Gifts not given yet include previously_given_year when a gift with the same title was given before.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gifts"
+                ],
+                "summary": "Get the gifts for a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "open",
+                            "idea",
+                            "purchased",
+                            "wrapped",
+                            "given"
+                        ],
+                        "type": "string",
+                        "description": "Only gifts not given yet (open) or with the given status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.GiftResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Add a gift idea for a birthday (must belong to authenticated user). New gifts start as ideas unless another status is given.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gifts"
+                ],
+                "summary": "Add a gift idea",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Gift details",
+                        "name": "gift",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateGiftRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.GiftResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/gifts/{gift}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a gift for a birthday (must belong to authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gifts"
+                ],
+                "summary": "Get a gift by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gift ID",
+                        "name": "gift",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.GiftResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday or gift not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace the details of a gift (must belong to authenticated user). An omitted status keeps the current one;\nstatuses can only move forward through idea, purchased, wrapped and given, but may skip steps. Given gifts default given_year to the current year.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gifts"
+                ],
+                "summary": "Update a gift",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gift ID",
+                        "name": "gift",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Gift details",
+                        "name": "details",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateGiftRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.GiftResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday or gift not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a gift for a birthday (must belong to authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gifts"
+                ],
+                "summary": "Delete a gift",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gift ID",
+                        "name": "gift",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday or gift not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List every recorded change of a birthday (must belong to authenticated user), newest first: creation, updates with a field-level diff, deletion, restore, reverts and merges.\nEach revision includes the acting user and a snapshot of the birthday after the change. Birthdays in the trash keep their history.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Get the revision history of a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayRevisionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid birthday ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/interactions": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the journal entries for a birthday (must belong to authenticated user), oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "interactions"
+                ],
+                "summary": "Get the interaction journal of a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.InteractionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Record a call, meeting, card, given gift or other interaction with the person of a birthday (must belong to authenticated user), optionally linked to one of their gifts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "interactions"
+                ],
+                "summary": "Add an interaction to the journal of a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Interaction details",
+                        "name": "interaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateInteractionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.InteractionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/interactions/{interaction}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a journal entry for a birthday (must belong to authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "interactions"
+                ],
+                "summary": "Get a journal entry by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Interaction ID",
+                        "name": "interaction",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.InteractionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday or interaction not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace the details of a journal entry (must belong to authenticated user). An omitted occurred_at keeps the current one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "interactions"
+                ],
+                "summary": "Update a journal entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Interaction ID",
+                        "name": "interaction",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Interaction details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateInteractionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.InteractionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday or interaction not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a journal entry for a birthday (must belong to authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "interactions"
+                ],
+                "summary": "Delete a journal entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Interaction ID",
+                        "name": "interaction",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday or interaction not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/observances": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the date a birthday is effectively observed in each year of a range, following the user's leap-day policy.\nFeb 29 birthdays are observed on Feb 28, Mar 1 or skipped in non-leap years depending on the policy.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Get observance dates of a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "First year of the range (defaults to the current year)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Last year of the range (defaults to from + 4)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.ObservanceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/photo": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Download the photo of a birthday (must belong to authenticated user)",
+                "produces": [
+                    "image/jpeg",
+                    "image/png"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Download a birthday photo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Photo",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "304": {
+                        "description": "Not modified"
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Attach a photo to a birthday (must belong to authenticated user), replacing the current one.\nJPEG, PNG and GIF images up to 10 MB and 40 megapixels are accepted; the format is detected from the content, not the file name.\nThe image is turned upright according to its EXIF orientation, scaled down to at most 2048 pixels per side and re-encoded, which removes EXIF and other metadata.\nJPEGs are stored as JPEG, PNGs and GIFs (first frame) as PNG. A 256x256 thumbnail cropped from the center is generated as well.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Upload a birthday photo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "photo",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayPhotoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or image",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Photo too large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported image format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Remove the photo and thumbnail of a birthday (must belong to authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Delete a birthday photo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/photo/thumbnail": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Download the 256x256 thumbnail of a birthday's photo (must belong to authenticated user)",
+                "produces": [
+                    "image/jpeg",
+                    "image/png"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Download a birthday photo thumbnail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Thumbnail",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "304": {
+                        "description": "Not modified"
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/relationships": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the people a birthday is related to (must belong to authenticated user), ordered by name. Relatives in the trash are left out.\nThe type names the relative's role, e.g. child when the relative is this person's child.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Get relatives of a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.RelationshipResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Relate a birthday to another birthday of the authenticated user. Relationships are bidirectional: the related birthday gets the inverse relationship,\nso adding Anna as John's child also lists John as Anna's parent. Two birthdays can be related in one way only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Relate two birthdays",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Related birthday and type",
+                        "name": "relationship",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateRelationshipRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.RelationshipResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday or related birthday not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Birthdays are already related",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/relationships/{relationship}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Remove a relationship from a birthday (must belong to authenticated user), in both directions. The related birthday is kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Remove a relationship",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Relationship ID",
+                        "name": "relationship",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday or relationship not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Take a birthday out of the trash (must belong to authenticated user). Its category is created again if it was deleted in the meantime.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Restore a deleted birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid birthday ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not in the trash",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/revert/{revision}": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Restore the name, birth date, category, notes, tags and contact details a birthday had after one of its revisions (must belong to authenticated user).\nThe revert is recorded as a new revision; deleted birthdays must be restored from the trash first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Revert a birthday to an earlier revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Revision ID",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Birthday or revision not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/tags": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Attach one or more tags to a birthday (must belong to authenticated user). Unknown tags are created.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Add tags to a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tags to add",
+                        "name": "tags",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.TagBirthdayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/tags/{tag}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Detach a tag from a birthday (must belong to authenticated user). The tag itself is kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Remove a tag from a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag name",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/birthdays/{id}/timeline": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the journal entries of a birthday (must belong to authenticated user) merged with its system events (created, updated, deleted, restored, reverted, merged), in chronological order.\nJournal entries have source journal and the interaction type; system events have source system, the revision action, the acting user and the changed fields.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "interactions"
+                ],
+                "summary": "Get the timeline of a birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.TimelineEntry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/budgets": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the gift budgets of the authenticated user, ordered by year with yearly budgets first, then category and birthday budgets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Get user's gift budgets",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only budgets for this year",
+                        "name": "year",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BudgetResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid year",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a gift budget for a year: a yearly budget when neither category_id nor birthday_id is given, otherwise a budget for one category or one person.\nThere is at most one budget per scope, year and currency.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Create a gift budget",
+                "parameters": [
+                    {
+                        "description": "Budget details",
+                        "name": "budget",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateBudgetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BudgetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Category or birthday not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Budget already exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/budgets/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a gift budget by its ID (must belong to authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Get a gift budget by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Budget ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BudgetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace the year, scope, amount and currency of a gift budget (must belong to authenticated user)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Update a gift budget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Budget ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Budget details",
+                        "name": "budget",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateBudgetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BudgetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Budget, category or birthday not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Budget already exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a gift budget (must belong to authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Delete a gift budget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Budget ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get all categories of the authenticated user, ordered by sort order and name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Get user's categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CategoryResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new birthday category for the authenticated user. Names are unique per user, case-insensitively.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Create a new category",
+                "parameters": [
+                    {
+                        "description": "Category details",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Category already exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a category by its ID (must belong to authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Get a category by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CategoryResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update a category (must belong to authenticated user). Renaming updates every birthday in the category atomically.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Update a category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Category details",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Another category already has this name",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete an empty category (must belong to authenticated user). Categories that still have birthdays must be merged instead.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Delete a category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Category still has birthdays",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Move every birthday of the source categories into this category and delete the sources, in one transaction",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Merge categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Target category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Categories to merge",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.MergeCategoriesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/celebrations": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the celebrations of all of the authenticated user's birthdays, by date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Get user's celebrations",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only celebrations from today on",
+                        "name": "upcoming",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/celebrations/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a celebration with its tasks, guests and RSVP links (its birthday must belong to authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Get a celebration by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Celebration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace the year, date, location and notes of a celebration (its birthday must belong to authenticated user)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Update a celebration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Celebration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Celebration details",
+                        "name": "celebration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateCelebrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a celebration with its tasks and guests (its birthday must belong to authenticated user). The guests' RSVP links stop working.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Delete a celebration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Celebration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/celebrations/{id}/guests": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Add a guest to the guest list of a celebration (its birthday must belong to authenticated user) and return the updated celebration, which includes the guest's public RSVP link",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Invite a guest to a celebration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Celebration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Guest details",
+                        "name": "guest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateCelebrationGuestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/celebrations/{id}/guests/{guest}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace a guest's name and contact, and optionally record their RSVP status (its birthday must belong to authenticated user). Returns the updated celebration.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Update a celebration guest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Celebration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Guest ID",
+                        "name": "guest",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Guest details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateCelebrationGuestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Celebration or guest not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Remove a guest from the guest list of a celebration (its birthday must belong to authenticated user), revoking their RSVP link. Returns the updated celebration.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Remove a celebration guest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Celebration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Guest ID",
+                        "name": "guest",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Celebration or guest not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/celebrations/{id}/tasks": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Add a task to the checklist of a celebration (its birthday must belong to authenticated user) and return the updated celebration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Add a task to a celebration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Celebration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task details",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateCelebrationTaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/celebrations/{id}/tasks/{task}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Rename a task or check it off (its birthday must belong to authenticated user) and return the updated celebration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Update a celebration task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Celebration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateCelebrationTaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Celebration or task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Remove a task from the checklist of a celebration (its birthday must belong to authenticated user) and return the updated celebration",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Delete a celebration task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Celebration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Celebration or task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a page of birthdays for the authenticated user, optionally filtered and sorted.\nPass the returned next_cursor as the cursor parameter to fetch the following page.\nGET /birthdays lists birthdays only; GET /events lists events of every type, or of the given type.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Get user's birthdays",
+                "parameters": [
+                    {
+                        "enum": [
+                            "birthday",
+                            "wedding_anniversary",
+                            "anniversary",
+                            "work_anniversary",
+                            "memorial",
+                            "name_day",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Filter by event type (GET /events only)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by category (case-insensitive)",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by birth month (1-12)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name prefix (case-insensitive)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search name, notes, phone numbers, emails, addresses and social handles (case-insensitive substring; phone numbers match regardless of formatting)",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by tags (comma-separated or repeated)",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "Whether birthdays must carry any or all of the tags",
+                        "name": "tag_mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "next",
+                            "name",
+                            "-name",
+                            "created_at",
+                            "-created_at"
+                        ],
+                        "type": "string",
+                        "default": "next",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Page size (1-100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new birthday record for the authenticated user.\nPOST /events creates events of any type given in event_type (default birthday); POST /birthdays only creates birthdays.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Create a new birthday or other event",
+                "parameters": [
+                    {
+                        "description": "Birthday details",
+                        "name": "birthday",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateBirthdayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Run up to 500 create, update and delete operations in one database transaction.\nWith all_or_nothing=true any failure rolls back the whole batch (422); otherwise failed operations are skipped.\nEvery operation gets a result with its index, status and validation error.\nPOST /birthdays/bulk only creates birthdays (400 for creates of other event types); POST /events/bulk creates events of any type.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Create, update and delete birthdays in bulk",
+                "parameters": [
+                    {
+                        "description": "Operations",
+                        "name": "bulk",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BulkBirthdayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Batch committed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BulkBirthdayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Batch rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BulkBirthdayResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/categories": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get every category of the authenticated user with its number of birthdays and the next upcoming birthday in it.\nUse GET /birthdays?category=X to list the birthdays of a single category.\nGET /events/categories counts events of every type, or of the given type.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Get birthday counts per category",
+                "parameters": [
+                    {
+                        "enum": [
+                            "birthday",
+                            "wedding_anniversary",
+                            "anniversary",
+                            "work_anniversary",
+                            "memorial",
+                            "name_day",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Event type (GET /events/categories only)",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CategoryStatsResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid type parameter",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/duplicates": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Group the authenticated user's birthdays that probably belong to the same person: they fall on the same month and day\nand their names are similar once case, punctuation and word order are ignored (equal, one containing all words of the other, or differing by a few typos).\nGroups are ordered by date and their birthdays oldest first; merge a group with POST /birthdays/merge.\nGET /events/duplicates finds duplicates among events of every type, or of the given type; only events of the same type are grouped.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Find likely duplicate birthdays",
+                "parameters": [
+                    {
+                        "enum": [
+                            "birthday",
+                            "wedding_anniversary",
+                            "anniversary",
+                            "work_anniversary",
+                            "memorial",
+                            "name_day",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Event type (GET /events/duplicates only)",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.DuplicateGroupResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid type parameter",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/export.csv": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Download all birthdays of the authenticated user as CSV with the columns name, birth_date, category, notes, event_type and partner_name, ordered by name.\nGET /events/export.csv exports events of every type, or of the given type.\nCells that spreadsheets would evaluate as formulas are prefixed with a single quote; the import removes it again.",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Export birthdays as CSV",
+                "parameters": [
+                    {
+                        "enum": [
+                            "birthday",
+                            "wedding_anniversary",
+                            "anniversary",
+                            "work_anniversary",
+                            "memorial",
+                            "name_day",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Event type (GET /events/export.csv only)",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid type parameter",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/import.csv": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Import birthdays from a CSV file with a header row, using the same validation as creating a birthday.\nColumns default to name, birth_date, category and notes (matched case-insensitively) and can be mapped to other headers.\nExisting birthdays with the same name (case-insensitive) are skipped, updated or duplicated according to on_duplicate.\nWith dry_run=true nothing is saved and the report shows what would happen. Files are limited to 5 MB and 5000 rows.\nRows with an event_type other than birthday are reported as invalid on POST /birthdays/import.csv; POST /events/import.csv imports every type.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Import birthdays from CSV",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Validate and report without saving",
+                        "name": "dry_run",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "skip",
+                            "update",
+                            "create"
+                        ],
+                        "type": "string",
+                        "default": "skip",
+                        "description": "What to do with rows whose name matches an existing birthday",
+                        "name": "on_duplicate",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category for rows without one",
+                        "name": "default_category",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "name",
+                        "description": "Header of the name column",
+                        "name": "name_column",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "birth_date",
+                        "description": "Header of the birth date column",
+                        "name": "birth_date_column",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "category",
+                        "description": "Header of the category column",
+                        "name": "category_column",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "notes",
+                        "description": "Header of the notes column",
+                        "name": "notes_column",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid file or options",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/import.ics": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Import yearly recurring events (RRULE:FREQ=YEARLY) from an .ics file exported by another calendar, using the same validation as creating a birthday.\nSUMMARY becomes the name (a trailing \"'s birthday\" is removed), DTSTART the birth date and DESCRIPTION the notes. The first CATEGORIES value becomes the category and the others become tags.\nEvents are remembered by UID, so importing the same file again skips them (or updates them with on_duplicate=update) instead of creating duplicates.\nEvents without a known UID are matched by name like the other imports; other events are skipped. Files are limited to 5 MB and 5000 events.\nAnniversaries, memorials and other events recognised from SUMMARY are reported as invalid on POST /birthdays/import.ics; POST /events/import.ics imports every type.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Import birthdays from an iCalendar file",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "iCalendar file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Validate and report without saving",
+                        "name": "dry_run",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "skip",
+                            "update",
+                            "create"
+                        ],
+                        "type": "string",
+                        "default": "skip",
+                        "description": "What to do with events that were imported before or whose name matches an existing birthday",
+                        "name": "on_duplicate",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category for events without CATEGORIES",
+                        "name": "default_category",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Treat every birth year as unknown, for calendars that start the series when the event was created",
+                        "name": "ignore_year",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid file or options",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/import.vcf": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Import birthdays from a vCard 3.0 or 4.0 file with one or more contacts, using the same validation as creating a birthday.\nFN (or N) becomes the name and BDAY the birth date (YYYYMMDD, YYYY-MM-DD, --MMDD or --MM-DD). The first CATEGORIES value becomes the category and the others become tags.\nContacts without BDAY are skipped. Existing birthdays with the same name (case-insensitive) are skipped, updated or duplicated according to on_duplicate.\nWith dry_run=true nothing is saved and the report shows what would happen. Files are limited to 5 MB and 5000 contacts.\nPOST /birthdays/import.vcf and POST /events/import.vcf behave the same, as contacts are always imported as birthdays.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Import birthdays from vCard contacts",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "vCard file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Validate and report without saving",
+                        "name": "dry_run",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "skip",
+                            "update",
+                            "create"
+                        ],
+                        "type": "string",
+                        "default": "skip",
+                        "description": "What to do with contacts whose name matches an existing birthday",
+                        "name": "on_duplicate",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category for contacts without CATEGORIES",
+                        "name": "default_category",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid file or options",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/trash": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List the authenticated user's birthdays in the trash, most recently deleted first, with the time each one is permanently deleted.\nGET /events/trash lists deleted events of every type, or of the given type.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "List deleted birthdays",
+                "parameters": [
+                    {
+                        "enum": [
+                            "birthday",
+                            "wedding_anniversary",
+                            "anniversary",
+                            "work_anniversary",
+                            "memorial",
+                            "name_day",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Event type (GET /events/trash only)",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.TrashedBirthdayResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid type parameter",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/types": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List the kinds of yearly recurring events with their icon and the wording of their years (e.g. \"age\" for birthdays, \"years married\" for wedding anniversaries).\nCouple types take a partner_name for the second person; years are not counted for name days.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List event types",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.EventType"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/upcoming": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the authenticated user's birthdays occurring within the next N days, ordered by next occurrence.\nDates are computed in the user's timezone, wrap from December to January and follow the user's leap-day policy.\nWith group_by=household the birthdays are grouped by household instead (response: array of models.UpcomingHouseholdResponse), groups ordered by their earliest birthday; birthdays without a household form one group with a null household_id.\nGET /events/upcoming includes events of every type, or of the given type.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "birthdays"
+                ],
+                "summary": "Get upcoming birthdays",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Window size in days, including today (1-366)",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "household"
+                        ],
+                        "type": "string",
+                        "description": "Group the birthdays",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "birthday",
+                            "wedding_anniversary",
+                            "anniversary",
+                            "work_anniversary",
+                            "memorial",
+                            "name_day",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Event type (GET /events/upcoming only)",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UpcomingBirthdayResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid days, group_by or type parameter",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/exports/{id}/download": {
+            "get": {
+                "description": "Download the ZIP archive of a ready export. Authenticated by the signed, time-limited link returned as download_url instead of a JWT.",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Download a data export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Link expiry (Unix time)",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link signature",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ZIP archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid export ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid or expired link",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "410": {
+                        "description": "Archive expired",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/feeds": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the settings of the authenticated user's calendar feed (without the token)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feeds"
+                ],
+                "summary": "Get the calendar feed",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CalendarFeedResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No feed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update the reminder settings of the authenticated user's calendar feed. The URL does not change.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feeds"
+                ],
+                "summary": "Update the calendar feed",
+                "parameters": [
+                    {
+                        "description": "Feed settings",
+                        "name": "feed",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CalendarFeedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CalendarFeedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No feed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a secret iCalendar subscription URL for the authenticated user's birthdays.\nThe token is only returned once; rotate it to get a new URL.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feeds"
+                ],
+                "summary": "Create the calendar feed",
+                "parameters": [
+                    {
+                        "description": "Feed settings",
+                        "name": "feed",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CalendarFeedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CalendarFeedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Feed already exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete the authenticated user's calendar feed. Its URL stops working immediately.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feeds"
+                ],
+                "summary": "Revoke the calendar feed",
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No feed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/feeds/rotate": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace the feed's secret token. The previous subscription URL stops working immediately.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feeds"
+                ],
+                "summary": "Rotate the calendar feed token",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CalendarFeedResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No feed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/feeds/{token}/birthdays.ics": {
+            "get": {
+                "description": "iCalendar feed of all of a user's birthdays as yearly recurring all-day events, for subscribing from calendar apps.\nAuthenticated by the secret token in the URL instead of a JWT.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "feeds"
+                ],
+                "summary": "Get the birthday calendar feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Feed token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "iCalendar feed",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Unknown or revoked token",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/gifts/upcoming": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the birthdays of the authenticated user observed within the next N days that have gifts not given yet, ordered by next occurrence, each with those gifts.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gifts"
+                ],
+                "summary": "Get open gift ideas for upcoming birthdays",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Window size in days, including today (1-366)",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UpcomingGiftsResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid days parameter",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/households": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get all households of the authenticated user with their member counts, ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Get user's households",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.HouseholdResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a household for the authenticated user. Households group birthdays of people who live together and can have their own address.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Create a household",
+                "parameters": [
+                    {
+                        "description": "Household details",
+                        "name": "household",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateHouseholdRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.HouseholdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/households/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a household by its ID (must belong to authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Get a household by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Household ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.HouseholdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace the name and address of a household (must belong to authenticated user)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Update a household",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Household ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Household details",
+                        "name": "household",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateHouseholdRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.HouseholdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a household (must belong to authenticated user). Its members are kept without a household.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Delete a household",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Household ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/households/{id}/members": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the birthdays in a household (must belong to authenticated user), ordered by name. Birthdays in the trash are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Get household members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Household ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/households/{id}/members/{birthday_id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Move a birthday into a household (both must belong to authenticated user). A birthday belongs to at most one household and leaves its previous one.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Add a household member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Household ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "birthday_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.HouseholdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Household or birthday not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Take a birthday out of a household (must belong to authenticated user). The birthday itself is kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Remove a household member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Household ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Birthday ID",
+                        "name": "birthday_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.HouseholdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Household or member not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Authenticate user and return JWT token for accessing protected endpoints\nThe returned token should be included in the Authorization header as \"Bearer \u003ctoken\u003e\"",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Login user",
+                "parameters": [
+                    {
+                        "description": "Login credentials",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully authenticated with JWT token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email or password",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/register": {
+            "post": {
+                "description": "Register a new user account with email and password\nAfter registration, use the /login endpoint to obtain a JWT token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "User registration details",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User successfully registered",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Email already exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/spending": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Compare the gift budgets of a year with the estimated and actual prices of the gifts for the birthdays in that year, in total and broken down by month, category and person.\nGiven gifts count towards their given_year, gifts not given yet towards the birthday's next occurrence. Amounts in different currencies are reported separately, never converted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get the gift spending report",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Year of the report (defaults to the current year)",
+                        "name": "year",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.SpendingReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid year",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/rsvp/{token}": {
+            "get": {
+                "description": "Get the celebration a guest is invited to and their current answer. Authenticated by the signed token of the guest's RSVP link instead of a JWT.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rsvp"
+                ],
+                "summary": "Get an invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RSVP token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.RSVPResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid link",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Invitation not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Accept or decline an invitation, or answer maybe, optionally with a message for the host. Guests can change their answer until they are removed from the guest list.\nAuthenticated by the signed token of the guest's RSVP link instead of a JWT.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rsvp"
+                ],
+                "summary": "Answer an invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RSVP token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Answer",
+                        "name": "rsvp",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.RSVPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.RSVPResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid link",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Invitation not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get all tags of the authenticated user with the number of birthdays carrying each",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Get user's tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.TagResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a tag and detach it from every birthday (must belong to authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Delete a tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the profile of the currently authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get current user's profile",
+                "responses": {
+                    "200": {
+                        "description": "User profile",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid JWT token",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update the profile of the currently authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update current user's profile",
+                "parameters": [
+                    {
+                        "description": "User update details",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated profile",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid JWT token",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete the account of the currently authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete current user's account",
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid JWT token",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/export": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get all data exports of the authenticated user, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user's data exports",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.DataExportResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Start building a ZIP archive with all of the authenticated user's data: the profile, birthdays with their revision history and relationships, categories, tags, households, gift ideas, budgets, journal entries, celebrations and calendar feed settings as JSON, plus the birthdays as CSV and iCalendar and their photos under photos/.\nThe archive is built in the background; poll the export until its status is ready and use download_url to fetch it.\nArchives are deleted when they expire.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Request a data export",
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.DataExportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "An export is already being built",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/export/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the status of a data export (must belong to authenticated user). Ready exports include a download link valid for one hour.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get a data export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.DataExportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid export ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_murathanje_birthday_tracking_backend_internal_models.Address": {
+            "description": "Postal address",
+            "type": "object",
+            "properties": {
+                "city": {
+                    "description": "@Description City or town",
+                    "type": "string",
+                    "example": "Berlin"
+                },
+                "country": {
+                    "description": "@Description ISO 3166-1 alpha-2 country code",
+                    "type": "string",
+                    "example": "DE"
+                },
+                "postal_code": {
+                    "description": "@Description Postal code",
+                    "type": "string",
+                    "example": "10115"
+                },
+                "region": {
+                    "description": "@Description State, province or region",
+                    "type": "string",
+                    "example": "Berlin"
+                },
+                "street": {
+                    "description": "@Description Street and house number, may span several lines",
+                    "type": "string",
+                    "example": "Hauptstraße 1"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayListResponse": {
+            "description": "Paginated list of birthdays",
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "@Description Birthdays in this page",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                    }
+                },
+                "next_cursor": {
+                    "description": "@Description Opaque cursor for the next page, null when there are no more results",
+                    "type": "string",
+                    "example": "eyJrIjo1MTUsIm4iOiJKb2huIERvZSIsImkiOiI1NTBlODQwMC1lMjliLTQxZDQtYTcxNi00NDY2NTU0NDAwMDAifQ"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayPhotoResponse": {
+            "description": "Response model for a birthday photo",
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "description": "@Description Content type of the stored photo",
+                    "type": "string",
+                    "example": "image/jpeg"
+                },
+                "height": {
+                    "description": "@Description Height of the stored photo in pixels",
+                    "type": "integer",
+                    "example": 2048
+                },
+                "size": {
+                    "description": "@Description Size of the stored photo in bytes",
+                    "type": "integer",
+                    "example": 284133
+                },
+                "thumbnail_url": {
+                    "description": "@Description Path to download the 256x256 thumbnail",
+                    "type": "string",
+                    "example": "/api/v1/birthdays/550e8400-e29b-41d4-a716-446655440000/photo/thumbnail"
+                },
+                "uploaded_at": {
+                    "description": "@Description When the photo was uploaded",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "@Description Path to download the photo",
+                    "type": "string",
+                    "example": "/api/v1/birthdays/550e8400-e29b-41d4-a716-446655440000/photo"
+                },
+                "width": {
+                    "description": "@Description Width of the stored photo in pixels",
+                    "type": "integer",
+                    "example": 1536
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse": {
+            "description": "Response model for birthday operations",
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "description": "@Description Postal addresses",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PostalAddress"
+                    }
+                },
+                "age": {
+                    "description": "@Description Current age in years (only present for birthdays when the birth year is known)",
+                    "type": "integer",
+                    "example": 34
+                },
+                "birth_date": {
+                    "description": "@Description Birthday date (format: YYYY-MM-DD, or MM-DD when the birth year is unknown); for other event types the date of the first occurrence",
+                    "type": "string",
+                    "example": "1990-05-15"
+                },
+                "category": {
+                    "description": "@Description Category of the birthday",
+                    "type": "string",
+                    "example": "Family"
+                },
+                "category_id": {
+                    "description": "@Description ID of the birthday's category",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "count_label": {
+                    "description": "@Description Wording of years and turning_years for the event type, e.g. \"age\" or \"years married\"; omitted when years are not counted",
+                    "type": "string",
+                    "example": "age"
+                },
+                "created_at": {
+                    "description": "@Description When the record was created",
+                    "type": "string"
+                },
+                "emails": {
+                    "description": "@Description Email addresses",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.EmailAddress"
+                    }
+                },
+                "event_type": {
+                    "description": "@Description Kind of event",
+                    "type": "string",
+                    "enum": [
+                        "birthday",
+                        "wedding_anniversary",
+                        "anniversary",
+                        "work_anniversary",
+                        "memorial",
+                        "name_day",
+                        "other"
+                    ],
+                    "example": "birthday"
+                },
+                "household_id": {
+                    "description": "@Description ID of the household the person belongs to",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440012"
+                },
+                "icon": {
+                    "description": "@Description Icon of the event type",
+                    "type": "string",
+                    "example": "🎂"
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the birthday record",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "description": "@Description Name of the person",
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "notes": {
+                    "description": "@Description Optional notes about the birthday",
+                    "type": "string",
+                    "example": "Best friend from college"
+                },
+                "partner_name": {
+                    "description": "@Description Second person of an anniversary",
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "phones": {
+                    "description": "@Description Phone numbers",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PhoneNumber"
+                    }
+                },
+                "photo": {
+                    "description": "@Description Photo of the person, if one was uploaded",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayPhotoResponse"
+                        }
+                    ]
+                },
+                "social_handles": {
+                    "description": "@Description Social network handles",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.SocialHandle"
+                    }
+                },
+                "tags": {
+                    "description": "@Description Tags attached to the birthday, sorted by name",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "college",
+                        "vip"
+                    ]
+                },
+                "turning_age": {
+                    "description": "@Description Age the person turns on the next occurrence (only present for birthdays when the birth year is known)",
+                    "type": "integer",
+                    "example": 35
+                },
+                "turning_years": {
+                    "description": "@Description Years completed on the next occurrence, worded by count_label (only present when the year is known and the event type counts years)",
+                    "type": "integer",
+                    "example": 35
+                },
+                "updated_at": {
+                    "description": "@Description When the record was last updated",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "@Description User ID who owns this birthday record",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "years": {
+                    "description": "@Description Completed years, worded by count_label (only present when the year is known and the event type counts years)",
+                    "type": "integer",
+                    "example": 34
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayRevisionResponse": {
+            "description": "Response model for a birthday revision",
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "@Description What happened to the birthday",
+                    "type": "string",
+                    "enum": [
+                        "created",
+                        "updated",
+                        "deleted",
+                        "restored",
+                        "reverted",
+                        "merged"
+                    ],
+                    "example": "updated"
+                },
+                "actor_id": {
+                    "description": "@Description User who made the change",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "birthday_id": {
+                    "description": "@Description Birthday the revision belongs to",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "changes": {
+                    "description": "@Description Changed fields with their previous and new values",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.RevisionChanges"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "description": "@Description When the change was made",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the revision",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440007"
+                },
+                "reverted_to": {
+                    "description": "@Description Revision whose version was restored, for reverted revisions",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440008"
+                },
+                "snapshot": {
+                    "description": "@Description The birthday as it was after the change",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdaySnapshot"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.BirthdaySnapshot": {
+            "description": "State of a birthday at one revision",
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "description": "@Description Postal addresses",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PostalAddress"
+                    }
+                },
+                "birth_date": {
+                    "description": "@Description Birthday date (format: YYYY-MM-DD or MM-DD)",
+                    "type": "string",
+                    "example": "1990-05-15"
+                },
+                "category": {
+                    "description": "@Description Category name",
+                    "type": "string",
+                    "example": "Family"
+                },
+                "emails": {
+                    "description": "@Description Email addresses",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.EmailAddress"
+                    }
+                },
+                "event_type": {
+                    "description": "@Description Kind of event; empty in revisions recorded before event types existed",
+                    "type": "string",
+                    "example": "birthday"
+                },
+                "name": {
+                    "description": "@Description Name of the person",
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "notes": {
+                    "description": "@Description Notes",
+                    "type": "string",
+                    "example": "Best friend from college"
+                },
+                "partner_name": {
+                    "description": "@Description Second person of an anniversary",
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "phones": {
+                    "description": "@Description Phone numbers",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PhoneNumber"
+                    }
+                },
+                "social_handles": {
+                    "description": "@Description Social network handles",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.SocialHandle"
+                    }
+                },
+                "tags": {
+                    "description": "@Description Tags, sorted by name",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "college",
+                        "vip"
+                    ]
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.BudgetResponse": {
+            "description": "Response model for budget operations",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "@Description Budgeted amount as a decimal string",
+                    "type": "string",
+                    "example": "500.00"
+                },
+                "birthday_id": {
+                    "description": "@Description Birthday the budget is for (birthday budgets only)",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "category_id": {
+                    "description": "@Description Category the budget is for (category budgets only)",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "created_at": {
+                    "description": "@Description When the budget was created",
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "@Description ISO 4217 currency code of the amount",
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the budget",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440014"
+                },
+                "scope": {
+                    "description": "@Description What the budget covers",
+                    "type": "string",
+                    "enum": [
+                        "year",
+                        "category",
+                        "birthday"
+                    ],
+                    "example": "category"
+                },
+                "updated_at": {
+                    "description": "@Description When the budget was last updated",
+                    "type": "string"
+                },
+                "year": {
+                    "description": "@Description Calendar year the budget is for",
+                    "type": "integer",
+                    "example": 2025
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.BulkBirthdayOperation": {
+            "description": "One create, update or delete operation",
+            "type": "object",
+            "properties": {
+                "birthday": {
+                    "description": "@Description Birthday details (required for create and update)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CreateBirthdayRequest"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "@Description Birthday ID (required for update and delete)",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "op": {
+                    "description": "@Description Operation kind",
+                    "type": "string",
+                    "enum": [
+                        "create",
+                        "update",
+                        "delete"
+                    ],
+                    "example": "create"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.BulkBirthdayRequest": {
+            "description": "Request model for bulk birthday operations",
+            "type": "object",
+            "required": [
+                "operations"
+            ],
+            "properties": {
+                "all_or_nothing": {
+                    "description": "@Description When true, any failing operation rolls back the whole batch; otherwise valid operations are kept",
+                    "type": "boolean",
+                    "example": true
+                },
+                "operations": {
+                    "description": "@Description Operations to run, in order",
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BulkBirthdayOperation"
+                    }
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.BulkBirthdayResponse": {
+            "description": "Response model for bulk birthday operations",
+            "type": "object",
+            "properties": {
+                "committed": {
+                    "description": "@Description Whether any changes were committed",
+                    "type": "boolean",
+                    "example": true
+                },
+                "results": {
+                    "description": "@Description Per-operation results, in request order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BulkBirthdayResult"
+                    }
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.BulkBirthdayResult": {
+            "description": "Outcome of one bulk operation",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "@Description Validation or processing error, for failed operations",
+                    "type": "string",
+                    "example": "invalid day for month 4"
+                },
+                "id": {
+                    "description": "@Description ID of the affected birthday",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "index": {
+                    "description": "@Description Position of the operation in the request",
+                    "type": "integer",
+                    "example": 0
+                },
+                "status": {
+                    "description": "@Description Outcome of the operation",
+                    "type": "string",
+                    "enum": [
+                        "created",
+                        "updated",
+                        "deleted",
+                        "failed",
+                        "rolled_back"
+                    ],
+                    "example": "created"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CalendarFeedRequest": {
+            "description": "Request model for creating or updating the calendar feed",
+            "type": "object",
+            "properties": {
+                "reminder_minutes": {
+                    "description": "@Description Minutes before the start of the birthday (midnight) to show a reminder; negative values remind later that day (e.g. -540 for 09:00). Omit or null for no reminder.",
+                    "type": "integer",
+                    "maximum": 40320,
+                    "minimum": -1439,
+                    "example": -540
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CalendarFeedResponse": {
+            "description": "Response model for the calendar feed",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "@Description When the feed was created",
+                    "type": "string"
+                },
+                "reminder_minutes": {
+                    "description": "@Description Minutes before the start of the birthday to show a reminder (null for none)",
+                    "type": "integer",
+                    "example": -540
+                },
+                "token": {
+                    "description": "@Description Secret feed token; only returned when the feed is created or rotated",
+                    "type": "string",
+                    "example": "kq3V0bN9sQ2xJ7cWm5YhR1tL8pZfA4uE6dG0iKoXy2M"
+                },
+                "updated_at": {
+                    "description": "@Description When the feed was last updated or rotated",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "@Description Subscription URL to add to a calendar app; only returned when the feed is created or rotated",
+                    "type": "string",
+                    "example": "https://example.com/api/v1/feeds/kq3V0bN9sQ2xJ7cWm5YhR1tL8pZfA4uE6dG0iKoXy2M/birthdays.ics"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CategoryResponse": {
+            "description": "Response model for category operations",
+            "type": "object",
+            "properties": {
+                "color": {
+                    "description": "@Description Display color (hex)",
+                    "type": "string",
+                    "example": "#FF8800"
+                },
+                "created_at": {
+                    "description": "@Description When the category was created",
+                    "type": "string"
+                },
+                "icon": {
+                    "description": "@Description Icon identifier",
+                    "type": "string",
+                    "example": "house"
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the category",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "name": {
+                    "description": "@Description Name of the category",
+                    "type": "string",
+                    "example": "Family"
+                },
+                "sort_order": {
+                    "description": "@Description Position of the category in lists",
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
+                    "description": "@Description When the category was last updated",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CategorySpending": {
+            "description": "Gift spending for the birthdays in one category",
+            "type": "object",
+            "properties": {
+                "amounts": {
+                    "description": "@Description Amounts per currency",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.SpendingAmounts"
+                    }
+                },
+                "category": {
+                    "description": "@Description Category name",
+                    "type": "string",
+                    "example": "Family"
+                },
+                "category_id": {
+                    "description": "@Description Category ID",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CategoryStatsResponse": {
+            "description": "Category with the number of birthdays in it and the next upcoming one",
+            "type": "object",
+            "properties": {
+                "birthday_count": {
+                    "description": "@Description Number of birthdays in the category",
+                    "type": "integer",
+                    "example": 12
+                },
+                "color": {
+                    "description": "@Description Display color (hex)",
+                    "type": "string",
+                    "example": "#FF8800"
+                },
+                "created_at": {
+                    "description": "@Description When the category was created",
+                    "type": "string"
+                },
+                "icon": {
+                    "description": "@Description Icon identifier",
+                    "type": "string",
+                    "example": "house"
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the category",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "name": {
+                    "description": "@Description Name of the category",
+                    "type": "string",
+                    "example": "Family"
+                },
+                "next_birthday": {
+                    "description": "@Description Next birthday observed in the category, null when the category is empty",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UpcomingBirthdayResponse"
+                        }
+                    ]
+                },
+                "sort_order": {
+                    "description": "@Description Position of the category in lists",
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
+                    "description": "@Description When the category was last updated",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationGuestResponse": {
+            "description": "Response model for celebration guests",
+            "type": "object",
+            "properties": {
+                "contact": {
+                    "description": "@Description How to reach the guest",
+                    "type": "string",
+                    "example": "jane@example.com"
+                },
+                "created_at": {
+                    "description": "@Description When the guest was added",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the guest",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440018"
+                },
+                "name": {
+                    "description": "@Description Name of the guest",
+                    "type": "string",
+                    "example": "Jane Smith"
+                },
+                "responded_at": {
+                    "description": "@Description When the guest last answered through the RSVP link",
+                    "type": "string"
+                },
+                "rsvp_message": {
+                    "description": "@Description Message the guest left with the answer",
+                    "type": "string",
+                    "example": "Looking forward to it!"
+                },
+                "rsvp_status": {
+                    "description": "@Description RSVP status",
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "accepted",
+                        "declined",
+                        "maybe"
+                    ],
+                    "example": "accepted"
+                },
+                "rsvp_url": {
+                    "description": "@Description Public link the guest can answer the invitation with, without an account",
+                    "type": "string",
+                    "example": "https://api.example.com/api/v1/rsvp/550e8400-e29b-41d4-a716-446655440018.3f2a..."
+                },
+                "updated_at": {
+                    "description": "@Description When the guest was last updated",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationResponse": {
+            "description": "Response model for celebration operations",
+            "type": "object",
+            "properties": {
+                "birthday_id": {
+                    "description": "@Description Birthday that is celebrated",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "count_label": {
+                    "description": "@Description Wording of turning_years for the event type, e.g. \"age\" or \"years married\"",
+                    "type": "string",
+                    "example": "age"
+                },
+                "created_at": {
+                    "description": "@Description When the celebration was created",
+                    "type": "string"
+                },
+                "date": {
+                    "description": "@Description Date of the celebration (format: YYYY-MM-DD)",
+                    "type": "string",
+                    "example": "2025-05-17"
+                },
+                "event_type": {
+                    "description": "@Description Kind of event that is celebrated",
+                    "type": "string",
+                    "example": "birthday"
+                },
+                "guests": {
+                    "description": "@Description Guest list, ordered by name",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationGuestResponse"
+                    }
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the celebration",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440016"
+                },
+                "location": {
+                    "description": "@Description Where the celebration takes place",
+                    "type": "string",
+                    "example": "Garden restaurant, Main Street 5"
+                },
+                "name": {
+                    "description": "@Description Name of the person, or of both people of an anniversary",
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "notes": {
+                    "description": "@Description Notes",
+                    "type": "string",
+                    "example": "Surprise party, don't tell John"
+                },
+                "rsvp": {
+                    "description": "@Description Number of guests per RSVP status",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.RSVPSummary"
+                        }
+                    ]
+                },
+                "tasks": {
+                    "description": "@Description Task checklist, oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationTaskResponse"
+                    }
+                },
+                "turning_age": {
+                    "description": "@Description Age the person turns in that year (only present for birthdays when the birth year is known)",
+                    "type": "integer",
+                    "example": 40
+                },
+                "turning_years": {
+                    "description": "@Description Years completed in that year, worded by count_label (only present when the year is known and the event type counts years)",
+                    "type": "integer",
+                    "example": 40
+                },
+                "updated_at": {
+                    "description": "@Description When the celebration was last updated",
+                    "type": "string"
+                },
+                "year": {
+                    "description": "@Description Year of the birthday occurrence that is celebrated",
+                    "type": "integer",
+                    "example": 2025
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CelebrationTaskResponse": {
+            "description": "Response model for celebration tasks",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "@Description When the task was created",
+                    "type": "string"
+                },
+                "done": {
+                    "description": "@Description Whether the task is done",
+                    "type": "boolean",
+                    "example": false
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the task",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440017"
+                },
+                "title": {
+                    "description": "@Description What needs to be done",
+                    "type": "string",
+                    "example": "Order the cake"
+                },
+                "updated_at": {
+                    "description": "@Description When the task was last updated",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateBirthdayRequest": {
+            "description": "Request model for creating a birthday record",
+            "type": "object",
+            "required": [
+                "birth_date",
+                "category",
+                "name"
+            ],
+            "properties": {
+                "addresses": {
+                    "description": "@Description Optional postal addresses (up to 20)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PostalAddress"
+                    }
+                },
+                "birth_date": {
+                    "description": "@Description Birthday date (format: YYYY-MM-DD, or MM-DD when the birth year is unknown); for other event types the date of the first occurrence, e.g. the wedding date",
+                    "type": "string",
+                    "example": "1990-05-15"
+                },
+                "category": {
+                    "description": "@Description Category name (e.g., \"Family\", \"Friend\", \"Work\"); matched case-insensitively and created if missing",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Family"
+                },
+                "emails": {
+                    "description": "@Description Optional email addresses (up to 20)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.EmailAddress"
+                    }
+                },
+                "event_type": {
+                    "description": "@Description Kind of event; defaults to birthday on create and is kept when omitted on updates",
+                    "type": "string",
+                    "enum": [
+                        "birthday",
+                        "wedding_anniversary",
+                        "anniversary",
+                        "work_anniversary",
+                        "memorial",
+                        "name_day",
+                        "other"
+                    ],
+                    "example": "birthday"
+                },
+                "name": {
+                    "description": "@Description Name of the person",
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "notes": {
+                    "description": "@Description Optional notes about the birthday",
+                    "type": "string",
+                    "example": "Best friend from college"
+                },
+                "partner_name": {
+                    "description": "@Description Second person of a wedding anniversary or anniversary",
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "phones": {
+                    "description": "@Description Optional phone numbers (up to 20)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PhoneNumber"
+                    }
+                },
+                "social_handles": {
+                    "description": "@Description Optional social network handles (up to 20)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.SocialHandle"
+                    }
+                },
+                "tags": {
+                    "description": "@Description Optional tags; unknown tags are created",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "college",
+                        "vip"
+                    ]
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateBudgetRequest": {
+            "description": "Request model for creating or updating a gift budget",
+            "type": "object",
+            "required": [
+                "currency",
+                "year"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "@Description Budgeted amount as a decimal string with at most two decimal places",
+                    "type": "string",
+                    "example": "500.00"
+                },
+                "birthday_id": {
+                    "description": "@Description Birthday the budget is for; omit both category_id and birthday_id for a yearly budget",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "category_id": {
+                    "description": "@Description Category the budget is for; omit both category_id and birthday_id for a yearly budget",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "currency": {
+                    "description": "@Description ISO 4217 currency code of the amount",
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "year": {
+                    "description": "@Description Calendar year the budget is for",
+                    "type": "integer",
+                    "example": 2025
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateCategoryRequest": {
+            "description": "Request model for creating or updating a birthday category",
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "color": {
+                    "description": "@Description Optional display color (hex, e.g. \"#FF8800\")",
+                    "type": "string",
+                    "example": "#FF8800"
+                },
+                "icon": {
+                    "description": "@Description Optional icon identifier used by clients",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "house"
+                },
+                "name": {
+                    "description": "@Description Name of the category (unique per user, case-insensitive)",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Family"
+                },
+                "sort_order": {
+                    "description": "@Description Position of the category in lists (lower comes first)",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateCelebrationGuestRequest": {
+            "description": "Request model for creating or updating a celebration guest",
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "contact": {
+                    "description": "@Description How to reach the guest, e.g. an email address or phone number",
+                    "type": "string",
+                    "example": "jane@example.com"
+                },
+                "name": {
+                    "description": "@Description Name of the guest",
+                    "type": "string",
+                    "example": "Jane Smith"
+                },
+                "rsvp_status": {
+                    "description": "@Description RSVP status, defaults to pending for new guests and is kept when omitted on updates",
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "accepted",
+                        "declined",
+                        "maybe"
+                    ],
+                    "example": "pending"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateCelebrationRequest": {
+            "description": "Request model for creating or updating a celebration",
+            "type": "object",
+            "required": [
+                "year"
+            ],
+            "properties": {
+                "date": {
+                    "description": "@Description Date of the celebration (format: YYYY-MM-DD), defaults to the birthday in that year; it must be in that year or within 31 days of the birthday",
+                    "type": "string",
+                    "example": "2025-05-17"
+                },
+                "location": {
+                    "description": "@Description Where the celebration takes place",
+                    "type": "string",
+                    "example": "Garden restaurant, Main Street 5"
+                },
+                "notes": {
+                    "description": "@Description Optional notes",
+                    "type": "string",
+                    "example": "Surprise party, don't tell John"
+                },
+                "year": {
+                    "description": "@Description Year of the birthday occurrence that is celebrated",
+                    "type": "integer",
+                    "example": 2025
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateCelebrationTaskRequest": {
+            "description": "Request model for creating or updating a celebration task",
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "done": {
+                    "description": "@Description Whether the task is done",
+                    "type": "boolean",
+                    "example": false
+                },
+                "title": {
+                    "description": "@Description What needs to be done",
+                    "type": "string",
+                    "example": "Order the cake"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateGiftRequest": {
+            "description": "Request model for creating or updating a gift idea",
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "actual_price": {
+                    "description": "@Description Optional amount actually spent, once the gift is purchased",
+                    "type": "string",
+                    "example": "139.00"
+                },
+                "currency": {
+                    "description": "@Description ISO 4217 currency code of both prices, required with either of them",
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "estimated_price": {
+                    "description": "@Description Optional estimated price as a decimal string with at most two decimal places",
+                    "type": "string",
+                    "example": "149.99"
+                },
+                "given_year": {
+                    "description": "@Description Year the gift was given; only allowed for given gifts, defaults to the current year",
+                    "type": "integer",
+                    "example": 2024
+                },
+                "priority": {
+                    "description": "@Description Priority, defaults to medium",
+                    "type": "string",
+                    "enum": [
+                        "high",
+                        "medium",
+                        "low"
+                    ],
+                    "example": "high"
+                },
+                "status": {
+                    "description": "@Description Status, defaults to idea; statuses can only move forward (idea, purchased, wrapped, given)",
+                    "type": "string",
+                    "enum": [
+                        "idea",
+                        "purchased",
+                        "wrapped",
+                        "given"
+                    ],
+                    "example": "idea"
+                },
+                "title": {
+                    "description": "@Description Short description of the gift",
+                    "type": "string",
+                    "example": "Espresso machine"
+                },
+                "url": {
+                    "description": "@Description Optional link to the gift (http or https)",
+                    "type": "string",
+                    "example": "https://example.com/espresso-machine"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateHouseholdRequest": {
+            "description": "Request model for creating or updating a household",
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "address": {
+                    "description": "@Description Optional address shared by the members",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.Address"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "@Description Name of the household",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "The Does"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateInteractionRequest": {
+            "description": "Request model for creating or updating an interaction journal entry",
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "gift_id": {
+                    "description": "@Description Optional gift of the same birthday the interaction is about",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440013"
+                },
+                "occurred_at": {
+                    "description": "@Description When the interaction happened; defaults to now for new entries and is kept when omitted on updates",
+                    "type": "string",
+                    "example": "2025-05-15T18:30:00Z"
+                },
+                "text": {
+                    "description": "@Description Free text about the interaction",
+                    "type": "string",
+                    "example": "Called to wish happy birthday, talked about the new job"
+                },
+                "type": {
+                    "description": "@Description Kind of interaction",
+                    "type": "string",
+                    "enum": [
+                        "called",
+                        "met",
+                        "sent_card",
+                        "gift_given",
+                        "other"
+                    ],
+                    "example": "called"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateRelationshipRequest": {
+            "description": "Request model for adding a relationship to a birthday",
+            "type": "object",
+            "required": [
+                "related_id",
+                "type"
+            ],
+            "properties": {
+                "related_id": {
+                    "description": "@Description ID of the related birthday",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440010"
+                },
+                "type": {
+                    "description": "@Description Role of the related person, e.g. child when the related person is this person's child",
+                    "type": "string",
+                    "enum": [
+                        "parent",
+                        "child",
+                        "sibling",
+                        "spouse",
+                        "partner",
+                        "grandparent",
+                        "grandchild",
+                        "aunt_uncle",
+                        "niece_nephew",
+                        "cousin",
+                        "in_law",
+                        "friend",
+                        "other"
+                    ],
+                    "example": "child"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateUserRequest": {
+            "description": "Request model for user creation",
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "description": "@Description User's email address (must be unique)",
+                    "type": "string",
+                    "example": "john.smith@example.com"
+                },
+                "leap_day_policy": {
+                    "description": "@Description When Feb 29 birthdays are observed in non-leap years (defaults to \"mar1\")",
+                    "enum": [
+                        "feb28",
+                        "mar1",
+                        "skip"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.LeapDayPolicy"
+                        }
+                    ],
+                    "example": "mar1"
+                },
+                "name": {
+                    "description": "@Description User's full name",
+                    "type": "string",
+                    "example": "John Smith"
+                },
+                "password": {
+                    "description": "@Description User's password (minimum 6 characters)\n@Required",
+                    "type": "string",
+                    "minLength": 6,
+                    "example": "secretpassword123"
+                },
+                "timezone": {
+                    "description": "@Description User's IANA timezone, used for upcoming birthday calculations (defaults to UTC)",
+                    "type": "string",
+                    "example": "Europe/Istanbul"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.DataExportResponse": {
+            "description": "Response model for data exports",
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "description": "@Description When the archive was built",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "@Description When the export was requested",
+                    "type": "string"
+                },
+                "download_expires_at": {
+                    "description": "@Description When the download link stops working",
+                    "type": "string"
+                },
+                "download_url": {
+                    "description": "@Description Time-limited download link, present while the export is ready",
+                    "type": "string",
+                    "example": "https://example.com/api/v1/exports/550e8400-e29b-41d4-a716-446655440005/download?expires=1735693200\u0026signature=3f1c..."
+                },
+                "error": {
+                    "description": "@Description Why the export failed",
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "@Description When the archive is deleted",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the export",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440005"
+                },
+                "size": {
+                    "description": "@Description Size of the archive in bytes, once ready",
+                    "type": "integer",
+                    "example": 20480
+                },
+                "status": {
+                    "description": "@Description Progress of the export",
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "running",
+                        "ready",
+                        "failed",
+                        "expired"
+                    ],
+                    "example": "ready"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.DuplicateGroupResponse": {
+            "description": "Group of likely duplicate birthdays",
+            "type": "object",
+            "properties": {
+                "birth_day": {
+                    "description": "@Description Shared birth day",
+                    "type": "integer",
+                    "example": 15
+                },
+                "birth_month": {
+                    "description": "@Description Shared birth month",
+                    "type": "integer",
+                    "example": 5
+                },
+                "birthdays": {
+                    "description": "@Description Birthdays in the group, oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse"
+                    }
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.EmailAddress": {
+            "description": "Email address of a person",
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "@Description Email address",
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "type": {
+                    "description": "@Description Kind of address (default: other)",
+                    "type": "string",
+                    "enum": [
+                        "personal",
+                        "work",
+                        "other"
+                    ],
+                    "example": "personal"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.EventType": {
+            "description": "Kind of yearly recurring event",
+            "type": "object",
+            "properties": {
+                "count_label": {
+                    "description": "@Description Wording of the number of years since the first occurrence, omitted when years are not counted",
+                    "type": "string",
+                    "example": "years married"
+                },
+                "couple": {
+                    "description": "@Description Whether events of this type involve two people (see partner_name)",
+                    "type": "boolean",
+                    "example": true
+                },
+                "icon": {
+                    "description": "@Description Icon shown next to events of this type",
+                    "type": "string",
+                    "example": "💍"
+                },
+                "key": {
+                    "description": "@Description Identifier used as event_type",
+                    "type": "string",
+                    "example": "wedding_anniversary"
+                },
+                "name": {
+                    "description": "@Description Display name",
+                    "type": "string",
+                    "example": "Wedding anniversary"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.FieldChange": {
+            "description": "Previous and new value of a field",
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "@Description Value before the change"
+                },
+                "to": {
+                    "description": "@Description Value after the change"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.GiftResponse": {
+            "description": "Response model for gift operations",
+            "type": "object",
+            "properties": {
+                "actual_price": {
+                    "description": "@Description Amount actually spent as a decimal string",
+                    "type": "string",
+                    "example": "139.00"
+                },
+                "birthday_id": {
+                    "description": "@Description ID of the birthday the gift is for",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "created_at": {
+                    "description": "@Description When the gift was created",
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "@Description ISO 4217 currency code of both prices",
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "estimated_price": {
+                    "description": "@Description Estimated price as a decimal string",
+                    "type": "string",
+                    "example": "149.99"
+                },
+                "given_year": {
+                    "description": "@Description Year the gift was given (only present for given gifts)",
+                    "type": "integer",
+                    "example": 2024
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the gift",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440013"
+                },
+                "previously_given_year": {
+                    "description": "@Description For gifts not given yet: the last year a gift with the same title was given to this person, to avoid repeating it",
+                    "type": "integer",
+                    "example": 2021
+                },
+                "priority": {
+                    "description": "@Description Priority",
+                    "type": "string",
+                    "enum": [
+                        "high",
+                        "medium",
+                        "low"
+                    ],
+                    "example": "high"
+                },
+                "status": {
+                    "description": "@Description Status",
+                    "type": "string",
+                    "enum": [
+                        "idea",
+                        "purchased",
+                        "wrapped",
+                        "given"
+                    ],
+                    "example": "idea"
+                },
+                "title": {
+                    "description": "@Description Short description of the gift",
+                    "type": "string",
+                    "example": "Espresso machine"
+                },
+                "updated_at": {
+                    "description": "@Description When the gift was last updated",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "@Description Link to the gift",
+                    "type": "string",
+                    "example": "https://example.com/espresso-machine"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.HouseholdResponse": {
+            "description": "Response model for household operations",
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "@Description Address shared by the members",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.Address"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "description": "@Description When the household was created",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the household",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440012"
+                },
+                "member_count": {
+                    "description": "@Description Number of members, not counting birthdays in the trash",
+                    "type": "integer",
+                    "example": 4
+                },
+                "name": {
+                    "description": "@Description Name of the household",
+                    "type": "string",
+                    "example": "The Does"
+                },
+                "updated_at": {
+                    "description": "@Description When the household was last updated",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.ImportReport": {
+            "description": "Import report with per-row results",
+            "type": "object",
+            "properties": {
+                "created": {
+                    "description": "@Description Number of birthdays created",
+                    "type": "integer",
+                    "example": 7
+                },
+                "dry_run": {
+                    "description": "@Description Whether this was a dry run (nothing was saved)",
+                    "type": "boolean",
+                    "example": false
+                },
+                "invalid": {
+                    "description": "@Description Number of entries that failed validation",
+                    "type": "integer",
+                    "example": 1
+                },
+                "rows": {
+                    "description": "@Description Per-entry results, in file order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.ImportRowResult"
+                    }
+                },
+                "skipped": {
+                    "description": "@Description Number of entries skipped",
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "description": "@Description Number of entries read from the file",
+                    "type": "integer",
+                    "example": 10
+                },
+                "updated": {
+                    "description": "@Description Number of existing birthdays updated",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.ImportRowResult": {
+            "description": "Outcome of one imported row",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "@Description Validation error or reason for skipping",
+                    "type": "string",
+                    "example": "invalid day for month 4"
+                },
+                "id": {
+                    "description": "@Description ID of the created or updated birthday (omitted in dry-run mode)",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "description": "@Description Name read from the entry",
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "row": {
+                    "description": "@Description Line number (CSV) or position (vCard, iCalendar) of the entry in the file, starting at 1",
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "description": "@Description Outcome of the entry",
+                    "type": "string",
+                    "enum": [
+                        "created",
+                        "updated",
+                        "skipped",
+                        "invalid"
+                    ],
+                    "example": "created"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.InteractionResponse": {
+            "description": "Response model for interaction journal entries",
+            "type": "object",
+            "properties": {
+                "birthday_id": {
+                    "description": "@Description Birthday the entry belongs to",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "created_at": {
+                    "description": "@Description When the entry was created",
+                    "type": "string"
+                },
+                "gift_id": {
+                    "description": "@Description Gift the interaction is about, if any",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440013"
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the entry",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440015"
+                },
+                "occurred_at": {
+                    "description": "@Description When the interaction happened",
+                    "type": "string"
+                },
+                "text": {
+                    "description": "@Description Free text about the interaction",
+                    "type": "string",
+                    "example": "Called to wish happy birthday, talked about the new job"
+                },
+                "type": {
+                    "description": "@Description Kind of interaction",
+                    "type": "string",
+                    "enum": [
+                        "called",
+                        "met",
+                        "sent_card",
+                        "gift_given",
+                        "other"
+                    ],
+                    "example": "called"
+                },
+                "updated_at": {
+                    "description": "@Description When the entry was last updated",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.LeapDayPolicy": {
+            "type": "string",
+            "enum": [
+                "feb28",
+                "mar1",
+                "skip"
+            ],
+            "x-enum-varnames": [
+                "LeapDayFeb28",
+                "LeapDayMar1",
+                "LeapDaySkip"
+            ]
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.LoginRequest": {
+            "description": "Request model for user login",
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "description": "@Description User's email address",
+                    "type": "string",
+                    "example": "john.smith@example.com"
+                },
+                "password": {
+                    "description": "@Description User's password",
+                    "type": "string",
+                    "example": "secretpassword123"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.LoginResponse": {
+            "description": "Response model for successful login",
+            "type": "object",
+            "properties": {
+                "token": {
+                    "description": "@Description JWT access token",
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "user": {
+                    "description": "@Description Basic user information",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UserResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.MergeBirthdaysRequest": {
+            "description": "Request model for merging duplicate birthdays",
+            "type": "object",
+            "required": [
+                "source_ids",
+                "survivor_id"
+            ],
+            "properties": {
+                "fields": {
+                    "description": "@Description Which birthday each conflicting field is taken from; fields that are omitted keep the survivor's value",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.MergeFieldChoices"
+                        }
+                    ]
+                },
+                "source_ids": {
+                    "description": "@Description IDs of the birthdays merged into the survivor; they are deleted afterwards",
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440006"
+                    ]
+                },
+                "survivor_id": {
+                    "description": "@Description ID of the birthday that is kept",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.MergeCategoriesRequest": {
+            "description": "Request model for merging categories",
+            "type": "object",
+            "required": [
+                "source_ids"
+            ],
+            "properties": {
+                "source_ids": {
+                    "description": "@Description IDs of the categories to merge into the target; they are deleted afterwards",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440002"
+                    ]
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.MergeFieldChoices": {
+            "description": "Source birthday for each conflicting field",
+            "type": "object",
+            "properties": {
+                "birth_date": {
+                    "description": "@Description Birthday whose birth date (including the year, if any) is kept",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440006"
+                },
+                "category": {
+                    "description": "@Description Birthday whose category is kept",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "description": "@Description Birthday whose name is kept",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440006"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.MonthSpending": {
+            "description": "Gift spending for the birthdays observed in one month",
+            "type": "object",
+            "properties": {
+                "amounts": {
+                    "description": "@Description Amounts per currency",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.SpendingAmounts"
+                    }
+                },
+                "month": {
+                    "description": "@Description Month (1-12)",
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.ObservanceResponse": {
+            "description": "Effective observance date of a birthday in a given year",
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "@Description Date the birthday is observed (format: YYYY-MM-DD), omitted when skipped",
+                    "type": "string",
+                    "example": "2025-03-01"
+                },
+                "observed": {
+                    "description": "@Description Whether the birthday is observed in this year",
+                    "type": "boolean",
+                    "example": true
+                },
+                "turning_age": {
+                    "description": "@Description Age the person turns in this year (only present for birthdays when the birth year is known)",
+                    "type": "integer",
+                    "example": 35
+                },
+                "turning_years": {
+                    "description": "@Description Years completed in this year, worded by the event type's count_label (only present when the year is known and the event type counts years)",
+                    "type": "integer",
+                    "example": 35
+                },
+                "year": {
+                    "description": "@Description Calendar year",
+                    "type": "integer",
+                    "example": 2025
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.PatchBirthdayRequest": {
+            "description": "Merge patch for a birthday record; omitted fields are left unchanged",
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "description": "@Description Full replacement list of postal addresses; null clears them",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PostalAddress"
+                    }
+                },
+                "birth_date": {
+                    "description": "@Description Birthday date (format: YYYY-MM-DD or MM-DD)",
+                    "type": "string",
+                    "example": "1990-05-15"
+                },
+                "category": {
+                    "description": "@Description Category name; unknown categories are created",
+                    "type": "string",
+                    "example": "Friend"
+                },
+                "emails": {
+                    "description": "@Description Full replacement list of email addresses; null clears them",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.EmailAddress"
+                    }
+                },
+                "event_type": {
+                    "description": "@Description Kind of event",
+                    "type": "string",
+                    "enum": [
+                        "birthday",
+                        "wedding_anniversary",
+                        "anniversary",
+                        "work_anniversary",
+                        "memorial",
+                        "name_day",
+                        "other"
+                    ],
+                    "example": "wedding_anniversary"
+                },
+                "name": {
+                    "description": "@Description Name of the person",
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "notes": {
+                    "description": "@Description Notes; null clears them",
+                    "type": "string",
+                    "example": "Moved to Berlin"
+                },
+                "partner_name": {
+                    "description": "@Description Second person of an anniversary; null clears it",
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "phones": {
+                    "description": "@Description Full replacement list of phone numbers; null clears them",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PhoneNumber"
+                    }
+                },
+                "social_handles": {
+                    "description": "@Description Full replacement list of social network handles; null clears them",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.SocialHandle"
+                    }
+                },
+                "tags": {
+                    "description": "@Description Full replacement tag list; null clears all tags",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "college"
+                    ]
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.PersonSpending": {
+            "description": "Gift spending for one person",
+            "type": "object",
+            "properties": {
+                "amounts": {
+                    "description": "@Description Amounts per currency",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.SpendingAmounts"
+                    }
+                },
+                "birthday_id": {
+                    "description": "@Description Birthday ID",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "description": "@Description Name of the person",
+                    "type": "string",
+                    "example": "John Doe"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.PhoneNumber": {
+            "description": "Phone number of a person",
+            "type": "object",
+            "properties": {
+                "number": {
+                    "description": "@Description Phone number with optional leading + and country code; spaces, dashes, dots and parentheses are allowed",
+                    "type": "string",
+                    "example": "+49 30 1234567"
+                },
+                "type": {
+                    "description": "@Description Kind of number (default: other)",
+                    "type": "string",
+                    "enum": [
+                        "mobile",
+                        "home",
+                        "work",
+                        "other"
+                    ],
+                    "example": "mobile"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.PostalAddress": {
+            "description": "Postal address of a person; at least one field besides type must be set",
+            "type": "object",
+            "properties": {
+                "city": {
+                    "description": "@Description City or town",
+                    "type": "string",
+                    "example": "Berlin"
+                },
+                "country": {
+                    "description": "@Description ISO 3166-1 alpha-2 country code",
+                    "type": "string",
+                    "example": "DE"
+                },
+                "postal_code": {
+                    "description": "@Description Postal code",
+                    "type": "string",
+                    "example": "10115"
+                },
+                "region": {
+                    "description": "@Description State, province or region",
+                    "type": "string",
+                    "example": "Berlin"
+                },
+                "street": {
+                    "description": "@Description Street and house number, may span several lines",
+                    "type": "string",
+                    "example": "Hauptstraße 1"
+                },
+                "type": {
+                    "description": "@Description Kind of address (default: other)",
+                    "type": "string",
+                    "enum": [
+                        "home",
+                        "work",
+                        "other"
+                    ],
+                    "example": "home"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.RSVPRequest": {
+            "description": "Request model for answering an invitation through an RSVP link",
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "message": {
+                    "description": "@Description Optional message for the host",
+                    "type": "string",
+                    "example": "Looking forward to it!"
+                },
+                "status": {
+                    "description": "@Description Answer to the invitation",
+                    "type": "string",
+                    "enum": [
+                        "accepted",
+                        "declined",
+                        "maybe"
+                    ],
+                    "example": "accepted"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.RSVPResponse": {
+            "description": "Invitation details shown to a guest through an RSVP link",
+            "type": "object",
+            "properties": {
+                "count_label": {
+                    "description": "@Description Wording of turning_years for the event type, e.g. \"age\" or \"years married\"",
+                    "type": "string",
+                    "example": "age"
+                },
+                "date": {
+                    "description": "@Description Date of the celebration (format: YYYY-MM-DD)",
+                    "type": "string",
+                    "example": "2025-05-17"
+                },
+                "event_type": {
+                    "description": "@Description Kind of event that is celebrated",
+                    "type": "string",
+                    "example": "birthday"
+                },
+                "guest": {
+                    "description": "@Description Name of the guest",
+                    "type": "string",
+                    "example": "Jane Smith"
+                },
+                "location": {
+                    "description": "@Description Where the celebration takes place",
+                    "type": "string",
+                    "example": "Garden restaurant, Main Street 5"
+                },
+                "message": {
+                    "description": "@Description Message the guest left with the answer",
+                    "type": "string",
+                    "example": "Looking forward to it!"
+                },
+                "name": {
+                    "description": "@Description Name of the person whose event is celebrated, or of both people of an anniversary",
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "responded_at": {
+                    "description": "@Description When the guest last answered",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "@Description Current answer of the guest",
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "accepted",
+                        "declined",
+                        "maybe"
+                    ],
+                    "example": "pending"
+                },
+                "turning_age": {
+                    "description": "@Description Age the person turns (only present for birthdays when the birth year is known)",
+                    "type": "integer",
+                    "example": 40
+                },
+                "turning_years": {
+                    "description": "@Description Years completed, worded by count_label (only present when the year is known and the event type counts years)",
+                    "type": "integer",
+                    "example": 40
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.RSVPSummary": {
+            "description": "Number of guests per RSVP status",
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "declined": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "maybe": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pending": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.RelationshipResponse": {
+            "description": "Response model for a relative of a birthday",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "@Description When the relationship was added",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "@Description Unique identifier for this direction of the relationship",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440011"
+                },
+                "related_birth_date": {
+                    "description": "@Description Birth date of the related person (format: YYYY-MM-DD or MM-DD)",
+                    "type": "string",
+                    "example": "2015-03-02"
+                },
+                "related_id": {
+                    "description": "@Description ID of the related birthday",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440010"
+                },
+                "related_name": {
+                    "description": "@Description Name of the related person",
+                    "type": "string",
+                    "example": "Anna Doe"
+                },
+                "type": {
+                    "description": "@Description Role of the related person",
+                    "type": "string",
+                    "enum": [
+                        "parent",
+                        "child",
+                        "sibling",
+                        "spouse",
+                        "partner",
+                        "grandparent",
+                        "grandchild",
+                        "aunt_uncle",
+                        "niece_nephew",
+                        "cousin",
+                        "in_law",
+                        "friend",
+                        "other"
+                    ],
+                    "example": "child"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.RevisionChanges": {
+            "type": "object",
+            "additionalProperties": {
+                "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.FieldChange"
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.SocialHandle": {
+            "description": "Social network handle of a person",
+            "type": "object",
+            "properties": {
+                "handle": {
+                    "description": "@Description User name on the network, without a leading @",
+                    "type": "string",
+                    "example": "johndoe"
+                },
+                "network": {
+                    "description": "@Description Network name in lower case, e.g. instagram, x, mastodon, linkedin",
+                    "type": "string",
+                    "example": "instagram"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.SpendingAmounts": {
+            "description": "Planned and actual gift spending in one currency",
+            "type": "object",
+            "properties": {
+                "actual": {
+                    "description": "@Description Sum of the amounts actually spent on the gifts",
+                    "type": "string",
+                    "example": "289.99"
+                },
+                "budget": {
+                    "description": "@Description Budgeted amount, if a budget was set for this currency",
+                    "type": "string",
+                    "example": "500.00"
+                },
+                "currency": {
+                    "description": "@Description ISO 4217 currency code; amounts in different currencies are never added up",
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "estimated": {
+                    "description": "@Description Sum of the estimated prices of the gifts",
+                    "type": "string",
+                    "example": "320.50"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.SpendingReportResponse": {
+            "description": "Planned versus actual gift spending in a year",
+            "type": "object",
+            "properties": {
+                "by_category": {
+                    "description": "@Description Spending per category, in category order, for categories with any amounts or budgets",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.CategorySpending"
+                    }
+                },
+                "by_month": {
+                    "description": "@Description Spending per month of the birthday, for months with any amounts",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.MonthSpending"
+                    }
+                },
+                "by_person": {
+                    "description": "@Description Spending per person, ordered by name, for people with any amounts or budgets",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PersonSpending"
+                    }
+                },
+                "totals": {
+                    "description": "@Description Totals per currency, with the yearly budget",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.SpendingAmounts"
+                    }
+                },
+                "year": {
+                    "description": "@Description Calendar year of the report",
+                    "type": "integer",
+                    "example": 2025
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.TagBirthdayRequest": {
+            "description": "Request model for tagging a birthday",
+            "type": "object",
+            "required": [
+                "tags"
+            ],
+            "properties": {
+                "tags": {
+                    "description": "@Description Tags to add; unknown tags are created. Tags are lower-cased and may not contain commas.",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
                     },
-                    "401": {
-                        "description": "Missing or invalid JWT token",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                    "example": [
+                        "college",
+                        "vip"
+                    ]
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.TagResponse": {
+            "description": "Response model for tags",
+            "type": "object",
+            "properties": {
+                "birthday_count": {
+                    "description": "@Description Number of birthdays carrying the tag",
+                    "type": "integer",
+                    "example": 4
+                },
+                "created_at": {
+                    "description": "@Description When the tag was created",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the tag",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440003"
+                },
+                "name": {
+                    "description": "@Description Name of the tag",
+                    "type": "string",
+                    "example": "college"
+                }
+            }
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.TimelineEntry": {
+            "description": "Journal entry or system event in the timeline of a birthday",
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "description": "@Description User who made the change, for system events",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "changes": {
+                    "description": "@Description Changed fields with their previous and new values, for system events",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.RevisionChanges"
                         }
-                    }
+                    ]
+                },
+                "gift_id": {
+                    "description": "@Description Gift a journal entry is about, if any",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440013"
+                },
+                "id": {
+                    "description": "@Description ID of the journal entry or revision",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440015"
+                },
+                "occurred_at": {
+                    "description": "@Description When it happened",
+                    "type": "string"
+                },
+                "source": {
+                    "description": "@Description Where the entry comes from: the interaction journal or the revision history",
+                    "type": "string",
+                    "enum": [
+                        "journal",
+                        "system"
+                    ],
+                    "example": "journal"
+                },
+                "text": {
+                    "description": "@Description Free text of journal entries",
+                    "type": "string",
+                    "example": "Called to wish happy birthday, talked about the new job"
+                },
+                "type": {
+                    "description": "@Description Interaction type for journal entries, revision action (created, updated, deleted, restored, reverted, merged) for system events",
+                    "type": "string",
+                    "example": "called"
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayResponse": {
-            "description": "Response model for birthday operations",
+        },
+        "github_com_murathanje_birthday_tracking_backend_internal_models.TrashedBirthdayResponse": {
+            "description": "Response model for a birthday in the trash",
             "type": "object",
             "properties": {
+                "addresses": {
+                    "description": "@Description Postal addresses",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PostalAddress"
+                    }
+                },
+                "age": {
+                    "description": "@Description Current age in years (only present for birthdays when the birth year is known)",
+                    "type": "integer",
+                    "example": 34
+                },
                 "birth_date": {
-                    "description": "@Description Birthday date (format: MM-DD)",
+                    "description": "@Description Birthday date (format: YYYY-MM-DD, or MM-DD when the birth year is unknown); for other event types the date of the first occurrence",
                     "type": "string",
-                    "example": "05-15"
+                    "example": "1990-05-15"
                 },
                 "category": {
                     "description": "@Description Category of the birthday",
                     "type": "string",
                     "example": "Family"
                 },
+                "category_id": {
+                    "description": "@Description ID of the birthday's category",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "count_label": {
+                    "description": "@Description Wording of years and turning_years for the event type, e.g. \"age\" or \"years married\"; omitted when years are not counted",
+                    "type": "string",
+                    "example": "age"
+                },
                 "created_at": {
                     "description": "@Description When the record was created",
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "@Description When the birthday was deleted",
+                    "type": "string"
+                },
+                "emails": {
+                    "description": "@Description Email addresses",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.EmailAddress"
+                    }
+                },
+                "event_type": {
+                    "description": "@Description Kind of event",
+                    "type": "string",
+                    "enum": [
+                        "birthday",
+                        "wedding_anniversary",
+                        "anniversary",
+                        "work_anniversary",
+                        "memorial",
+                        "name_day",
+                        "other"
+                    ],
+                    "example": "birthday"
+                },
+                "household_id": {
+                    "description": "@Description ID of the household the person belongs to",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440012"
+                },
+                "icon": {
+                    "description": "@Description Icon of the event type",
+                    "type": "string",
+                    "example": "🎂"
+                },
                 "id": {
                     "description": "@Description Unique identifier for the birthday record",
                     "type": "string",
@@ -821,6 +10755,58 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Best friend from college"
                 },
+                "partner_name": {
+                    "description": "@Description Second person of an anniversary",
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "phones": {
+                    "description": "@Description Phone numbers",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PhoneNumber"
+                    }
+                },
+                "photo": {
+                    "description": "@Description Photo of the person, if one was uploaded",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayPhotoResponse"
+                        }
+                    ]
+                },
+                "purge_at": {
+                    "description": "@Description When the birthday will be permanently deleted unless it is restored",
+                    "type": "string"
+                },
+                "social_handles": {
+                    "description": "@Description Social network handles",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.SocialHandle"
+                    }
+                },
+                "tags": {
+                    "description": "@Description Tags attached to the birthday, sorted by name",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "college",
+                        "vip"
+                    ]
+                },
+                "turning_age": {
+                    "description": "@Description Age the person turns on the next occurrence (only present for birthdays when the birth year is known)",
+                    "type": "integer",
+                    "example": 35
+                },
+                "turning_years": {
+                    "description": "@Description Years completed on the next occurrence, worded by count_label (only present when the year is known and the event type counts years)",
+                    "type": "integer",
+                    "example": 35
+                },
                 "updated_at": {
                     "description": "@Description When the record was last updated",
                     "type": "string"
@@ -829,103 +10815,192 @@ const docTemplate = `{
                     "description": "@Description User ID who owns this birthday record",
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "years": {
+                    "description": "@Description Completed years, worded by count_label (only present when the year is known and the event type counts years)",
+                    "type": "integer",
+                    "example": 34
                 }
             }
         },
-        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateBirthdayRequest": {
-            "description": "Request model for creating a birthday record",
+        "github_com_murathanje_birthday_tracking_backend_internal_models.UpcomingBirthdayResponse": {
+            "description": "Response model for upcoming birthdays",
             "type": "object",
-            "required": [
-                "birth_date",
-                "category",
-                "name"
-            ],
             "properties": {
+                "addresses": {
+                    "description": "@Description Postal addresses",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PostalAddress"
+                    }
+                },
+                "age": {
+                    "description": "@Description Current age in years (only present for birthdays when the birth year is known)",
+                    "type": "integer",
+                    "example": 34
+                },
                 "birth_date": {
-                    "description": "@Description Birthday date (format: MM-DD)",
+                    "description": "@Description Birthday date (format: YYYY-MM-DD, or MM-DD when the birth year is unknown); for other event types the date of the first occurrence",
                     "type": "string",
-                    "example": "05-15"
+                    "example": "1990-05-15"
                 },
                 "category": {
-                    "description": "@Description Category of the birthday (e.g., \"Family\", \"Friend\", \"Work\")",
+                    "description": "@Description Category of the birthday",
                     "type": "string",
                     "example": "Family"
                 },
+                "category_id": {
+                    "description": "@Description ID of the birthday's category",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "count_label": {
+                    "description": "@Description Wording of years and turning_years for the event type, e.g. \"age\" or \"years married\"; omitted when years are not counted",
+                    "type": "string",
+                    "example": "age"
+                },
+                "created_at": {
+                    "description": "@Description When the record was created",
+                    "type": "string"
+                },
+                "days_until": {
+                    "description": "@Description Number of days from today until the next occurrence (0 means today)",
+                    "type": "integer",
+                    "example": 12
+                },
+                "emails": {
+                    "description": "@Description Email addresses",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.EmailAddress"
+                    }
+                },
+                "event_type": {
+                    "description": "@Description Kind of event",
+                    "type": "string",
+                    "enum": [
+                        "birthday",
+                        "wedding_anniversary",
+                        "anniversary",
+                        "work_anniversary",
+                        "memorial",
+                        "name_day",
+                        "other"
+                    ],
+                    "example": "birthday"
+                },
+                "household_id": {
+                    "description": "@Description ID of the household the person belongs to",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440012"
+                },
+                "icon": {
+                    "description": "@Description Icon of the event type",
+                    "type": "string",
+                    "example": "🎂"
+                },
+                "id": {
+                    "description": "@Description Unique identifier for the birthday record",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
                 "name": {
                     "description": "@Description Name of the person",
                     "type": "string",
                     "example": "John Doe"
                 },
+                "next_date": {
+                    "description": "@Description Date of the next occurrence in the user's timezone (format: YYYY-MM-DD)",
+                    "type": "string",
+                    "example": "2025-05-15"
+                },
                 "notes": {
                     "description": "@Description Optional notes about the birthday",
                     "type": "string",
                     "example": "Best friend from college"
-                }
-            }
-        },
-        "github_com_murathanje_birthday_tracking_backend_internal_models.CreateUserRequest": {
-            "description": "Request model for user creation",
-            "type": "object",
-            "required": [
-                "email",
-                "name",
-                "password"
-            ],
-            "properties": {
-                "email": {
-                    "description": "@Description User's email address (must be unique)",
-                    "type": "string",
-                    "example": "john.smith@example.com"
                 },
-                "name": {
-                    "description": "@Description User's full name",
+                "partner_name": {
+                    "description": "@Description Second person of an anniversary",
                     "type": "string",
-                    "example": "John Smith"
+                    "example": "Jane Doe"
                 },
-                "password": {
-                    "description": "@Description User's password (minimum 6 characters)\n@Required",
-                    "type": "string",
-                    "minLength": 6,
-                    "example": "secretpassword123"
-                }
-            }
-        },
-        "github_com_murathanje_birthday_tracking_backend_internal_models.LoginRequest": {
-            "description": "Request model for user login",
-            "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
-            "properties": {
-                "email": {
-                    "description": "@Description User's email address",
-                    "type": "string",
-                    "example": "john.smith@example.com"
+                "phones": {
+                    "description": "@Description Phone numbers",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.PhoneNumber"
+                    }
                 },
-                "password": {
-                    "description": "@Description User's password",
+                "photo": {
+                    "description": "@Description Photo of the person, if one was uploaded",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.BirthdayPhotoResponse"
+                        }
+                    ]
+                },
+                "social_handles": {
+                    "description": "@Description Social network handles",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.SocialHandle"
+                    }
+                },
+                "tags": {
+                    "description": "@Description Tags attached to the birthday, sorted by name",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "college",
+                        "vip"
+                    ]
+                },
+                "turning_age": {
+                    "description": "@Description Age the person turns on the next occurrence (only present for birthdays when the birth year is known)",
+                    "type": "integer",
+                    "example": 35
+                },
+                "turning_years": {
+                    "description": "@Description Years completed on the next occurrence, worded by count_label (only present when the year is known and the event type counts years)",
+                    "type": "integer",
+                    "example": 35
+                },
+                "updated_at": {
+                    "description": "@Description When the record was last updated",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "@Description User ID who owns this birthday record",
                     "type": "string",
-                    "example": "secretpassword123"
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "years": {
+                    "description": "@Description Completed years, worded by count_label (only present when the year is known and the event type counts years)",
+                    "type": "integer",
+                    "example": 34
                 }
             }
         },
-        "github_com_murathanje_birthday_tracking_backend_internal_models.LoginResponse": {
-            "description": "Response model for successful login",
+        "github_com_murathanje_birthday_tracking_backend_internal_models.UpcomingGiftsResponse": {
+            "description": "Open gift ideas for an upcoming birthday",
             "type": "object",
             "properties": {
-                "token": {
-                    "description": "@Description JWT access token",
-                    "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
-                },
-                "user": {
-                    "description": "@Description Basic user information",
+                "birthday": {
+                    "description": "@Description The upcoming birthday",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UserResponse"
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.UpcomingBirthdayResponse"
                         }
                     ]
+                },
+                "gifts": {
+                    "description": "@Description Gifts not given yet, by priority and then oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.GiftResponse"
+                    }
                 }
             }
         },
@@ -942,6 +11017,20 @@ const docTemplate = `{
                     "type": "string",
                     "example": "john.smith@example.com"
                 },
+                "leap_day_policy": {
+                    "description": "@Description When Feb 29 birthdays are observed in non-leap years (optional, left unchanged if empty)",
+                    "enum": [
+                        "feb28",
+                        "mar1",
+                        "skip"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.LeapDayPolicy"
+                        }
+                    ],
+                    "example": "mar1"
+                },
                 "name": {
                     "description": "@Description User's full name",
                     "type": "string",
@@ -952,6 +11041,11 @@ const docTemplate = `{
                     "type": "string",
                     "minLength": 6,
                     "example": "newpassword123"
+                },
+                "timezone": {
+                    "description": "@Description User's IANA timezone (optional, left unchanged if empty)",
+                    "type": "string",
+                    "example": "Europe/Istanbul"
                 }
             }
         },
@@ -974,11 +11068,25 @@ const docTemplate = `{
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
+                "leap_day_policy": {
+                    "description": "@Description When Feb 29 birthdays are observed in non-leap years (\"feb28\", \"mar1\" or \"skip\")",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_murathanje_birthday_tracking_backend_internal_models.LeapDayPolicy"
+                        }
+                    ],
+                    "example": "mar1"
+                },
                 "name": {
                     "description": "@Description User's full name",
                     "type": "string",
                     "example": "John Smith"
                 },
+                "timezone": {
+                    "description": "@Description User's IANA timezone",
+                    "type": "string",
+                    "example": "Europe/Istanbul"
+                },
                 "updated_at": {
                     "description": "@Description When the user was last updated",
                     "type": "string",
@@ -1015,8 +11123,48 @@ const docTemplate = `{
             "name": "admin"
         },
         {
-            "description": "Birthday management endpoints with string-based categorization (requires JWT authentication)",
+            "description": "Birthday management endpoints (requires JWT authentication)",
             "name": "birthdays"
+        },
+        {
+            "description": "Yearly recurring event types (requires JWT authentication)",
+            "name": "events"
+        },
+        {
+            "description": "Birthday category management endpoints (requires JWT authentication)",
+            "name": "categories"
+        },
+        {
+            "description": "Tag management endpoints (requires JWT authentication)",
+            "name": "tags"
+        },
+        {
+            "description": "Gift idea endpoints (requires JWT authentication)",
+            "name": "gifts"
+        },
+        {
+            "description": "Interaction journal and timeline endpoints (requires JWT authentication)",
+            "name": "interactions"
+        },
+        {
+            "description": "Celebration planning endpoints (requires JWT authentication)",
+            "name": "celebrations"
+        },
+        {
+            "description": "Public RSVP endpoints for invited guests (authenticated by a signed token)",
+            "name": "rsvp"
+        },
+        {
+            "description": "Gift budget endpoints (requires JWT authentication)",
+            "name": "budgets"
+        },
+        {
+            "description": "Spending report endpoints (requires JWT authentication)",
+            "name": "reports"
+        },
+        {
+            "description": "iCalendar subscription feed endpoints (management requires JWT authentication, the feed itself a secret token)",
+            "name": "feeds"
         }
     ]
 }`
@@ -1028,7 +11176,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/api/v1",
 	Schemes:          []string{"https"},
 	Title:            "Birthday Tracking API",
-	Description:      "A birthday tracking service API in Go using Gin framework.\nFeatures:\n- User management with JWT authentication for user operations\n- API Key authentication for admin operations\n- Birthday tracking with simple categorization (string-based)\n- Example categories: \"Family\", \"Friend\", \"Work\", \"School\", etc.\n- Upcoming birthdays tracking\n\nAuthentication:\n1. For Users:\n- Register a new account using /api/v1/register\n- Login with your credentials at /api/v1/login to get a JWT token\n- Use the token in the Authorization header for protected endpoints\n- Format: \"Bearer <your_jwt_token>\"\n2. For Admins:\n- Use API Key in the X-API-Key header for admin endpoints\n- The API Key should be set in your .env file\n\nEndpoints:\n1. Auth Endpoints (Public):\n- POST /api/v1/register - Create new account\n- POST /api/v1/login - Get JWT token\n2. User Endpoints (Requires JWT):\n- GET /api/v1/users/me - Get own profile\n- PUT /api/v1/users/me - Update own profile\n- DELETE /api/v1/users/me - Delete own account\n3. Admin Endpoints (Requires API Key):\n- GET /api/v1/admin/users - List all users\n- GET /api/v1/admin/users/{id} - Get any user\n- PUT /api/v1/admin/users/{id} - Update any user\n- DELETE /api/v1/admin/users/{id} - Delete any user\n4. Birthday Endpoints (Requires JWT):\n- POST /api/v1/birthdays - Create birthday (with category as string)\n- GET /api/v1/birthdays - List own birthdays\n- GET /api/v1/birthdays/{id} - Get specific birthday\n- PUT /api/v1/birthdays/{id} - Update birthday\n- DELETE /api/v1/birthdays/{id} - Delete birthday\n\nBirthday Categories:\nCategories are now implemented as simple strings. You can use any string value\nfor categorization. Some suggested categories:\n- \"Family\" - For family members\n- \"Friend\" - For friends\n- \"Work\" - For work colleagues\n- \"School\" - For school/university friends\n- \"Other\" - For any other category",
+	Description:      "A birthday tracking service API in Go using Gin framework.\nFeatures:\n- User management with JWT authentication for user operations\n- API Key authentication for admin operations\n- Birthday tracking with per-user categories (name, color, icon, sort order)\n- Example categories: \"Family\", \"Friend\", \"Work\", \"School\", etc.\n- Multi-label tagging of birthdays (e.g. \"college\", \"book-club\", \"vip\")\n- Upcoming birthdays tracking, optionally grouped by household\n- Typed, bidirectional relationships between birthdays and households with their own address\n- Gift ideas per birthday, tracked from idea to given\n- Gift budgets per year, category or person, with a planned versus actual spending report\n- Interaction journal per birthday and a timeline merging it with the birthday's history\n- Celebration planning with a task checklist, a guest list and public RSVP links\n- Other yearly events besides birthdays: wedding and work anniversaries, anniversaries, memorials and name days,\neach with its own icon and wording of the years (e.g. \"years married\"); upcoming lists, gifts, the calendar feed and exports cover every type\n\nAuthentication:\n1. For Users:\n- Register a new account using /api/v1/register\n- Login with your credentials at /api/v1/login to get a JWT token\n- Use the token in the Authorization header for protected endpoints\n- Format: \"Bearer <your_jwt_token>\"\n2. For Admins:\n- Use API Key in the X-API-Key header for admin endpoints\n- The API Key should be set in your .env file\n\nEndpoints:\n1. Auth Endpoints (Public):\n- POST /api/v1/register - Create new account\n- POST /api/v1/login - Get JWT token\n2. User Endpoints (Requires JWT):\n- GET /api/v1/users/me - Get own profile\n- PUT /api/v1/users/me - Update own profile\n- DELETE /api/v1/users/me - Delete own account\n- POST /api/v1/users/me/export - Request a ZIP archive of all own data (built asynchronously)\n- GET /api/v1/users/me/export - List own data exports\n- GET /api/v1/users/me/export/{id} - Get export status and time-limited download link\n- GET /api/v1/exports/{id}/download - Download export archive (authenticated by signed link)\n3. Admin Endpoints (Requires API Key):\n- GET /api/v1/admin/users - List all users\n- GET /api/v1/admin/users/{id} - Get any user\n- PUT /api/v1/admin/users/{id} - Update any user\n- DELETE /api/v1/admin/users/{id} - Delete any user\n4. Birthday Endpoints (Requires JWT):\n- POST /api/v1/birthdays - Create birthday (with category as string)\nbirth_date accepts \"YYYY-MM-DD\" or \"MM-DD\"; age fields are returned when the year is known\nevent_type defaults to birthday; other types are created through POST /api/v1/events\n- POST /api/v1/birthdays/bulk - Create, update and delete birthdays in one transaction\nbulk creates and imports under /birthdays accept birthdays only\n- POST /api/v1/birthdays/import.csv - Import birthdays from CSV (column mapping, dry run, duplicate policy)\n- POST /api/v1/birthdays/import.vcf - Import birthdays from vCard contacts (BDAY, FN, CATEGORIES)\n- POST /api/v1/birthdays/import.ics - Import yearly events from an iCalendar file (idempotent by UID)\n- GET /api/v1/birthdays/export.csv - Export own birthdays as CSV\n- GET /api/v1/birthdays - List own birthdays (filterable, searchable, sortable, cursor-paginated)\n- GET /api/v1/birthdays/upcoming?days=N - List birthdays in the next N days (group_by=household to group them)\n- GET /api/v1/birthdays/categories - List categories with counts and next birthday\n- GET /api/v1/birthdays/duplicates - Find likely duplicates (same date, similar name)\n- POST /api/v1/birthdays/merge - Merge duplicates into one birthday\n- GET /api/v1/birthdays/{id} - Get specific birthday\n- GET /api/v1/birthdays/{id}/observances - Get observance dates per year (leap-day policy aware)\n- PUT /api/v1/birthdays/{id} - Update birthday\n- PATCH /api/v1/birthdays/{id} - Partially update birthday (JSON merge patch)\n- DELETE /api/v1/birthdays/{id} - Move birthday to the trash\n- GET /api/v1/birthdays/trash - List deleted birthdays\n- POST /api/v1/birthdays/{id}/restore - Restore deleted birthday\n- GET /api/v1/birthdays/{id}/history - List revisions with field-level diffs\n- POST /api/v1/birthdays/{id}/revert/{revision} - Revert birthday to an earlier revision\n- PUT /api/v1/birthdays/{id}/photo - Upload photo (JPEG, PNG or GIF)\n- GET /api/v1/birthdays/{id}/photo - Download photo\n- GET /api/v1/birthdays/{id}/photo/thumbnail - Download photo thumbnail\n- DELETE /api/v1/birthdays/{id}/photo - Delete photo\n- POST /api/v1/birthdays/{id}/tags - Add tags to birthday\n- DELETE /api/v1/birthdays/{id}/tags/{tag} - Remove tag from birthday\n- GET /api/v1/birthdays/{id}/relationships - List relatives\n- POST /api/v1/birthdays/{id}/relationships - Relate to another birthday (inverse added automatically)\n- DELETE /api/v1/birthdays/{id}/relationships/{relationship} - Remove relationship in both directions\n- GET /api/v1/birthdays/{id}/gifts - List gifts (status=open for those not given yet)\n- POST /api/v1/birthdays/{id}/gifts - Add gift idea\n- GET /api/v1/birthdays/{id}/gifts/{gift} - Get gift\n- PUT /api/v1/birthdays/{id}/gifts/{gift} - Update gift (status only moves forward: idea, purchased, wrapped, given)\n- DELETE /api/v1/birthdays/{id}/gifts/{gift} - Delete gift\n- GET /api/v1/birthdays/{id}/interactions - List journal entries, oldest first\n- POST /api/v1/birthdays/{id}/interactions - Add journal entry (called, met, sent_card, gift_given, other)\n- GET /api/v1/birthdays/{id}/interactions/{interaction} - Get journal entry\n- PUT /api/v1/birthdays/{id}/interactions/{interaction} - Update journal entry\n- DELETE /api/v1/birthdays/{id}/interactions/{interaction} - Delete journal entry\n- GET /api/v1/birthdays/{id}/timeline - Journal entries and system events in chronological order\n- GET /api/v1/birthdays/{id}/celebrations - List celebrations of a birthday\n- POST /api/v1/birthdays/{id}/celebrations - Plan celebration of the birthday in a year\n5. Event Endpoints (Requires JWT):\nThe birthday list endpoints above only return birthdays; these return events of every type, or of the one given as type.\nSingle events of any type are addressed through /api/v1/birthdays/{id}.\n- GET /api/v1/events/types - List event types with icon, count label and whether they involve two people\n- POST /api/v1/events - Create event of any type (event_type, partner_name for couples)\n- GET /api/v1/events?type=T - List events (same filters as GET /api/v1/birthdays)\n- GET /api/v1/events/upcoming?days=N&type=T - List events in the next N days\n- GET /api/v1/events/categories?type=T - List categories with event counts and next event\n- GET /api/v1/events/duplicates?type=T - Find likely duplicates among events of the same type\n- GET /api/v1/events/trash?type=T - List deleted events\n- GET /api/v1/events/export.csv?type=T - Export events as CSV\n- POST /api/v1/events/bulk, /import.csv, /import.vcf, /import.ics - Bulk operations and imports of any event type\n6. Category Endpoints (Requires JWT):\n- POST /api/v1/categories - Create category\n- GET /api/v1/categories - List own categories\n- GET /api/v1/categories/{id} - Get specific category\n- PUT /api/v1/categories/{id} - Update category (renames cascade to birthdays)\n- DELETE /api/v1/categories/{id} - Delete empty category\n- POST /api/v1/categories/{id}/merge - Merge other categories into this one\n7. Tag Endpoints (Requires JWT):\n- GET /api/v1/tags - List own tags with usage counts\n- DELETE /api/v1/tags/{id} - Delete tag\n8. Household Endpoints (Requires JWT):\n- POST /api/v1/households - Create household\n- GET /api/v1/households - List own households with member counts\n- GET /api/v1/households/{id} - Get specific household\n- PUT /api/v1/households/{id} - Update household name and address\n- DELETE /api/v1/households/{id} - Delete household (members are kept)\n- GET /api/v1/households/{id}/members - List members\n- PUT /api/v1/households/{id}/members/{birthday_id} - Move birthday into household\n- DELETE /api/v1/households/{id}/members/{birthday_id} - Take birthday out of household\n9. Gift Endpoints (Requires JWT):\n- GET /api/v1/gifts/upcoming?days=N - List open gift ideas for birthdays and other events in the next N days\n10. Budget Endpoints (Requires JWT):\n- POST /api/v1/budgets - Create budget for a year, a category or a birthday\n- GET /api/v1/budgets?year=YYYY - List budgets\n- GET /api/v1/budgets/{id} - Get budget\n- PUT /api/v1/budgets/{id} - Update budget\n- DELETE /api/v1/budgets/{id} - Delete budget\n- GET /api/v1/reports/spending?year=YYYY - Planned versus actual gift spending by month, category and person\n11. Celebration Endpoints (Requires JWT unless noted):\n- GET /api/v1/celebrations?upcoming=true - List celebrations, by date\n- GET /api/v1/celebrations/{id} - Get celebration with tasks, guests and RSVP links\n- PUT /api/v1/celebrations/{id} - Update celebration\n- DELETE /api/v1/celebrations/{id} - Delete celebration\n- POST /api/v1/celebrations/{id}/tasks - Add task\n- PUT /api/v1/celebrations/{id}/tasks/{task} - Update or check off task\n- DELETE /api/v1/celebrations/{id}/tasks/{task} - Delete task\n- POST /api/v1/celebrations/{id}/guests - Invite guest\n- PUT /api/v1/celebrations/{id}/guests/{guest} - Update guest\n- DELETE /api/v1/celebrations/{id}/guests/{guest} - Remove guest (revokes the RSVP link)\n- GET /api/v1/rsvp/{token} - Get invitation (authenticated by the guest's signed RSVP token)\n- PUT /api/v1/rsvp/{token} - Answer invitation (authenticated by the guest's signed RSVP token)\n12. Calendar Feed Endpoints:\n- POST /api/v1/feeds - Create secret calendar feed URL (Requires JWT)\n- GET /api/v1/feeds - Get feed settings (Requires JWT)\n- PUT /api/v1/feeds - Update feed reminder (Requires JWT)\n- POST /api/v1/feeds/rotate - Rotate feed token (Requires JWT)\n- DELETE /api/v1/feeds - Revoke feed (Requires JWT)\n- GET /api/v1/feeds/{token}/birthdays.ics - iCalendar feed (authenticated by token)\n\nBirthday Categories:\nCategories are per-user records. Birthdays reference a category by name, matched\ncase-insensitively; unknown names create a new category. Some suggested categories:\n- \"Family\" - For family members\n- \"Friend\" - For friends\n- \"Work\" - For work colleagues\n- \"School\" - For school/university friends\n- \"Other\" - For any other category",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
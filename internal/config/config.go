@@ -3,7 +3,9 @@ package config
 import (
 	"log"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -18,6 +20,20 @@ type Config struct {
 	GinMode    string
 	APIKey     string
 	JWTSecret  string
+	ExportDir  string
+	ExportTTL  int
+
+	PublicBaseURL string
+
+	TrashRetentionDays int
+
+	StorageBackend    string
+	StorageDir        string
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
 }
 
 func LoadConfig() *Config {
@@ -35,6 +51,20 @@ func LoadConfig() *Config {
         GinMode:    getEnv("GIN_MODE", "debug"),
         APIKey:     getEnv("API_KEY", "default-api-key"),
         JWTSecret:  getEnv("JWT_SECRET", "default-jwt-secret"),
+        ExportDir:  getEnv("EXPORT_DIR", filepath.Join(os.TempDir(), "birthday-exports")),
+        ExportTTL:  getEnvAsInt("EXPORT_TTL_HOURS", 24),
+
+        PublicBaseURL: strings.TrimSuffix(getEnv("PUBLIC_BASE_URL", ""), "/"),
+
+        TrashRetentionDays: getEnvAsInt("TRASH_RETENTION_DAYS", 30),
+
+        StorageBackend:    getEnv("STORAGE_BACKEND", "local"),
+        StorageDir:        getEnv("STORAGE_DIR", filepath.Join(os.TempDir(), "birthday-photos")),
+        S3Endpoint:        getEnv("S3_ENDPOINT", ""),
+        S3Region:          getEnv("S3_REGION", "us-east-1"),
+        S3Bucket:          getEnv("S3_BUCKET", ""),
+        S3AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+        S3SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
     }
 }
 
@@ -0,0 +1,122 @@
+// Package contentline reads the folded "NAME;PARAM=VALUE:value" content lines
+// shared by vCard (RFC 6350) and iCalendar (RFC 5545) files.
+package contentline
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// Property is one unfolded content line
+type Property struct {
+	// Name is upper-cased and without its group prefix
+	Name string
+	// Params are keyed by upper-cased name, with quotes removed
+	Params map[string]string
+	// Value is the raw, still escaped value
+	Value string
+}
+
+// Read returns the properties in r in order. Continuation lines, which
+// start with a space or tab, are joined onto the line before them; blank
+// lines and lines without a colon are skipped.
+func Read(r io.Reader) ([]Property, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) == 0 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		if len(lines) > 0 && line != "" && (line[0] == ' ' || line[0] == '\t') {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	properties := make([]Property, 0, len(lines))
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if prop, ok := parse(line); ok {
+			properties = append(properties, prop)
+		}
+	}
+	return properties, nil
+}
+
+// parse splits a content line into name, parameters and value. Colons inside
+// quoted parameter values do not end the name.
+func parse(line string) (Property, bool) {
+	quoted := false
+	colon := -1
+	for i := 0; i < len(line); i++ {
+		if line[i] == '"' {
+			quoted = !quoted
+		} else if line[i] == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return Property{}, false
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	name := strings.ToUpper(parts[0])
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+
+	params := make(map[string]string, len(parts)-1)
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+
+	return Property{Name: name, Params: params, Value: line[colon+1:]}, true
+}
+
+// Split splits value at unescaped separators and unescapes the parts
+func Split(value string, sep byte) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' {
+			i++
+			continue
+		}
+		if value[i] == sep {
+			parts = append(parts, Unescape(value[start:i]))
+			start = i + 1
+		}
+	}
+	return append(parts, Unescape(value[start:]))
+}
+
+// Unescape resolves backslash escapes in a text value
+func Unescape(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			i++
+			switch value[i] {
+			case 'n', 'N':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(value[i])
+			}
+			continue
+		}
+		b.WriteByte(value[i])
+	}
+	return b.String()
+}
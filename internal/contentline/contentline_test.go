@@ -0,0 +1,109 @@
+package contentline
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Property
+	}{
+		{
+			name:  "simple",
+			input: "BEGIN:VCARD\r\nFN:Jane Doe\r\nEND:VCARD\r\n",
+			want: []Property{
+				{Name: "BEGIN", Params: map[string]string{}, Value: "VCARD"},
+				{Name: "FN", Params: map[string]string{}, Value: "Jane Doe"},
+				{Name: "END", Params: map[string]string{}, Value: "VCARD"},
+			},
+		},
+		{
+			name:  "folded lines",
+			input: "NOTE:This is a lo\r\n ng note\r\n\tcontinued\r\nFN:Jane\r\n",
+			want: []Property{
+				{Name: "NOTE", Params: map[string]string{}, Value: "This is a long notecontinued"},
+				{Name: "FN", Params: map[string]string{}, Value: "Jane"},
+			},
+		},
+		{
+			name:  "quoted colon in a parameter",
+			input: `ATTENDEE;CN="Doe: Jane";ROLE=REQ-PARTICIPANT:mailto:jane@example.com` + "\n",
+			want: []Property{
+				{Name: "ATTENDEE", Params: map[string]string{"CN": "Doe: Jane", "ROLE": "REQ-PARTICIPANT"}, Value: "mailto:jane@example.com"},
+			},
+		},
+		{
+			name:  "group prefix and lower-case names",
+			input: "item1.bday;x-apple-omit-year=1604:1604-03-15\n",
+			want: []Property{
+				{Name: "BDAY", Params: map[string]string{"X-APPLE-OMIT-YEAR": "1604"}, Value: "1604-03-15"},
+			},
+		},
+		{
+			name:  "byte order mark, blank lines and lines without a colon",
+			input: "\ufeffBEGIN:VCARD\n\n   \nnot a property\nEND:VCARD\n",
+			want: []Property{
+				{Name: "BEGIN", Params: map[string]string{}, Value: "VCARD"},
+				{Name: "END", Params: map[string]string{}, Value: "VCARD"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Read(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Read() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		value string
+		sep   byte
+		want  []string
+	}{
+		{value: "Friends,Work", sep: ',', want: []string{"Friends", "Work"}},
+		{value: `Friends\, close,Work`, sep: ',', want: []string{"Friends, close", "Work"}},
+		{value: `a\\,b`, sep: ',', want: []string{`a\`, "b"}},
+		{value: "", sep: ',', want: []string{""}},
+		{value: `Doe;Jane;;Dr.;`, sep: ';', want: []string{"Doe", "Jane", "", "Dr.", ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := Split(tt.value, tt.sep); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Split(%q, %q) = %q, want %q", tt.value, tt.sep, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnescape(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "plain", want: "plain"},
+		{value: `a\, b\; c\\ d`, want: `a, b; c\ d`},
+		{value: `line\nbreak\Nagain`, want: "line\nbreak\nagain"},
+		{value: `trailing\`, want: `trailing\`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := Unescape(tt.value); got != tt.want {
+				t.Errorf("Unescape(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,15 @@
 package handler
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"strconv"
-	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -12,15 +18,29 @@ import (
 	"github.com/murathanje/birthday_tracking_backend/internal/service"
 )
 
+// maxImportSize is the largest request body accepted by the import endpoints
+const maxImportSize = 5 << 20
+
+// maxPhotoSize is the largest request body accepted by the photo upload
+const maxPhotoSize = 10 << 20
+
+// eventTypeKey is the gin context key of the event type that the collection
+// endpoints of a route group are restricted to
+const eventTypeKey = "eventType"
+
 type BirthdayHandler struct {
-	birthdayService *service.BirthdayService
-	userService     *service.UserService
+	birthdayService  *service.BirthdayService
+	photoService     *service.PhotoService
+	householdService *service.HouseholdService
+	userService      *service.UserService
 }
 
-func NewBirthdayHandler(birthdayService *service.BirthdayService, userService *service.UserService) *BirthdayHandler {
+func NewBirthdayHandler(birthdayService *service.BirthdayService, photoService *service.PhotoService, householdService *service.HouseholdService, userService *service.UserService) *BirthdayHandler {
 	return &BirthdayHandler{
-		birthdayService: birthdayService,
-		userService:     userService,
+		birthdayService:  birthdayService,
+		photoService:     photoService,
+		householdService: householdService,
+		userService:      userService,
 	}
 }
 
@@ -29,19 +49,128 @@ func (h *BirthdayHandler) RegisterRoutes(r *gin.Engine) {
 	birthdays := api.Group("/birthdays")
 	birthdays.Use(middleware.JWTAuth(func() []byte {
 		return h.userService.GetJWTSecret()
-	}))
+	}), restrictEventType(models.EventTypeBirthday))
 	{
 		birthdays.POST("", h.CreateBirthday)
+		birthdays.POST("/bulk", h.BulkBirthdays)
+		birthdays.POST("/merge", h.MergeBirthdays)
+		birthdays.POST("/import.csv", h.ImportBirthdaysCSV)
+		birthdays.POST("/import.vcf", h.ImportBirthdaysVCard)
+		birthdays.POST("/import.ics", h.ImportBirthdaysICS)
+		birthdays.GET("/export.csv", h.ExportBirthdaysCSV)
 		birthdays.GET("", h.GetUserBirthdays)
+		birthdays.GET("/upcoming", h.GetUpcomingBirthdays)
+		birthdays.GET("/duplicates", h.GetDuplicateBirthdays)
+		birthdays.GET("/trash", h.GetTrashedBirthdays)
+		birthdays.GET("/categories", h.GetBirthdayCategories)
 		birthdays.GET("/:id", h.GetBirthdayByID)
+		birthdays.GET("/:id/observances", h.GetBirthdayObservances)
+		birthdays.POST("/:id/tags", h.AddBirthdayTags)
+		birthdays.DELETE("/:id/tags/:tag", h.RemoveBirthdayTag)
 		birthdays.PUT("/:id", h.UpdateBirthday)
+		birthdays.PATCH("/:id", h.PatchBirthday)
 		birthdays.DELETE("/:id", h.DeleteBirthday)
+		birthdays.POST("/:id/restore", h.RestoreBirthday)
+		birthdays.GET("/:id/history", h.GetBirthdayHistory)
+		birthdays.POST("/:id/revert/:revision", h.RevertBirthday)
+		birthdays.PUT("/:id/photo", h.UploadBirthdayPhoto)
+		birthdays.GET("/:id/photo", h.GetBirthdayPhoto)
+		birthdays.GET("/:id/photo/thumbnail", h.GetBirthdayPhotoThumbnail)
+		birthdays.DELETE("/:id/photo", h.DeleteBirthdayPhoto)
+		birthdays.GET("/:id/relationships", h.GetBirthdayRelationships)
+		birthdays.POST("/:id/relationships", h.AddBirthdayRelationship)
+		birthdays.DELETE("/:id/relationships/:relationship", h.RemoveBirthdayRelationship)
+	}
+
+	// The birthday routes above list birthdays only; these list events of
+	// every type. Single events are addressed through /birthdays/:id.
+	events := api.Group("/events")
+	events.Use(middleware.JWTAuth(func() []byte {
+		return h.userService.GetJWTSecret()
+	}))
+	{
+		events.GET("/types", h.GetEventTypes)
+		events.POST("", h.CreateBirthday)
+		events.POST("/bulk", h.BulkBirthdays)
+		events.POST("/import.csv", h.ImportBirthdaysCSV)
+		events.POST("/import.vcf", h.ImportBirthdaysVCard)
+		events.POST("/import.ics", h.ImportBirthdaysICS)
+		events.GET("", h.GetUserBirthdays)
+		events.GET("/upcoming", h.GetUpcomingBirthdays)
+		events.GET("/duplicates", h.GetDuplicateBirthdays)
+		events.GET("/trash", h.GetTrashedBirthdays)
+		events.GET("/categories", h.GetBirthdayCategories)
+		events.GET("/export.csv", h.ExportBirthdaysCSV)
+	}
+}
+
+// restrictEventType restricts the collection endpoints of a route group to
+// events of eventType
+func restrictEventType(eventType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(eventTypeKey, eventType)
+		c.Next()
+	}
+}
+
+// eventTypeScope returns the event type the collection endpoints are restricted
+// to: birthday on the /birthdays routes, and the type query parameter on the
+// /events routes, where an empty type means every type. It writes the error
+// response and returns false when the type is unknown.
+func eventTypeScope(c *gin.Context) (string, bool) {
+	if eventType := c.GetString(eventTypeKey); eventType != "" {
+		return eventType, true
+	}
+
+	eventType := c.Query("type")
+	if eventType != "" {
+		if _, ok := models.LookupEventType(eventType); !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid type parameter"})
+			return "", false
+		}
+	}
+	return eventType, true
+}
+
+// calendar returns the user's date settings, falling back to UTC and the
+// default leap-day policy when the user cannot be loaded.
+func (h *BirthdayHandler) calendar(userID uuid.UUID) models.Calendar {
+	user, err := h.userService.GetUserByID(userID)
+	if err != nil {
+		return models.DefaultCalendar(time.Now())
+	}
+	return user.Calendar(time.Now())
+}
+
+// ownedBirthday loads the birthday named by the :id path parameter and checks
+// that it belongs to the authenticated user. It writes the error response and
+// returns nil when the birthday cannot be used.
+func (h *BirthdayHandler) ownedBirthday(c *gin.Context) *models.Birthday {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		return nil
+	}
+
+	birthday, err := h.birthdayService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found"})
+		return nil
 	}
+
+	userID, _ := middleware.GetUserID(c)
+	if birthday.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return nil
+	}
+
+	return birthday
 }
 
 // CreateBirthday godoc
-// @Summary Create a new birthday
-// @Description Create a new birthday record for the authenticated user
+// @Summary Create a new birthday or other event
+// @Description Create a new birthday record for the authenticated user.
+// @Description POST /events creates events of any type given in event_type (default birthday); POST /birthdays only creates birthdays.
 // @Tags birthdays
 // @Accept json
 // @Produce json
@@ -52,6 +181,7 @@ func (h *BirthdayHandler) RegisterRoutes(r *gin.Engine) {
 // @Failure 401 {object} map[string]string "Unauthorized"
 // @Failure 500 {object} map[string]string "Server error"
 // @Router /birthdays [post]
+// @Router /events [post]
 func (h *BirthdayHandler) CreateBirthday(c *gin.Context) {
 	var req models.CreateBirthdayRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -59,6 +189,14 @@ func (h *BirthdayHandler) CreateBirthday(c *gin.Context) {
 		return
 	}
 
+	if restricted := c.GetString(eventTypeKey); restricted != "" {
+		if req.EventType != "" && req.EventType != restricted {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Only birthdays can be created here, use POST /events for other event types"})
+			return
+		}
+		req.EventType = restricted
+	}
+
 	userID, err := middleware.GetUserID(c)
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
@@ -66,186 +204,1354 @@ func (h *BirthdayHandler) CreateBirthday(c *gin.Context) {
 	}
 
 	birthday, err := h.birthdayService.CreateBirthday(userID, &req)
-	if err != nil {
+	if errors.Is(err, service.ErrInvalidBirthday) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create birthday"})
+		return
+	}
 
-	c.JSON(http.StatusCreated, birthday.ToResponse())
+	c.JSON(http.StatusCreated, birthday.ToResponse(h.calendar(userID)))
 }
 
-// GetUserBirthdays godoc
-// @Summary Get user's birthdays
-// @Description Get all birthdays for the authenticated user
+// BulkBirthdays godoc
+// @Summary Create, update and delete birthdays in bulk
+// @Description Run up to 500 create, update and delete operations in one database transaction.
+// @Description With all_or_nothing=true any failure rolls back the whole batch (422); otherwise failed operations are skipped.
+// @Description Every operation gets a result with its index, status and validation error.
+// @Description POST /birthdays/bulk only creates birthdays (400 for creates of other event types); POST /events/bulk creates events of any type.
 // @Tags birthdays
+// @Accept json
 // @Produce json
 // @Security Bearer
-// @Success 200 {array} models.BirthdayResponse
+// @Param bulk body models.BulkBirthdayRequest true "Operations"
+// @Success 200 {object} models.BulkBirthdayResponse "Batch committed"
+// @Failure 400 {object} map[string]string "Invalid request body"
 // @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 422 {object} models.BulkBirthdayResponse "Batch rolled back"
 // @Failure 500 {object} map[string]string "Server error"
-// @Router /birthdays [get]
-func (h *BirthdayHandler) GetUserBirthdays(c *gin.Context) {
+// @Router /birthdays/bulk [post]
+// @Router /events/bulk [post]
+func (h *BirthdayHandler) BulkBirthdays(c *gin.Context) {
+	var req models.BulkBirthdayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	if restricted := c.GetString(eventTypeKey); restricted != "" {
+		for i := range req.Operations {
+			op := &req.Operations[i]
+			if op.Op != models.BulkOpCreate || op.Birthday == nil {
+				continue
+			}
+			if op.Birthday.EventType != "" && op.Birthday.EventType != restricted {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("operations[%d]: Only birthdays can be created here, use POST /events/bulk for other event types", i)})
+				return
+			}
+			op.Birthday.EventType = restricted
+		}
+	}
+
 	userID, err := middleware.GetUserID(c)
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	birthdays, err := h.birthdayService.GetByUserID(userID)
+	response, err := h.birthdayService.Bulk(userID, &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch birthdays"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to run bulk operations"})
 		return
 	}
 
-	response := make([]*models.BirthdayResponse, len(birthdays))
-	for i, birthday := range birthdays {
-		response[i] = birthday.ToResponse()
+	status := http.StatusOK
+	if !response.Committed {
+		status = http.StatusUnprocessableEntity
 	}
-
-	c.JSON(http.StatusOK, response)
+	c.JSON(status, response)
 }
 
-// GetBirthdayByID godoc
-// @Summary Get a birthday by ID
-// @Description Get a birthday record by its ID (must belong to authenticated user)
+// GetDuplicateBirthdays godoc
+// @Summary Find likely duplicate birthdays
+// @Description Group the authenticated user's birthdays that probably belong to the same person: they fall on the same month and day
+// @Description and their names are similar once case, punctuation and word order are ignored (equal, one containing all words of the other, or differing by a few typos).
+// @Description Groups are ordered by date and their birthdays oldest first; merge a group with POST /birthdays/merge.
+// @Description GET /events/duplicates finds duplicates among events of every type, or of the given type; only events of the same type are grouped.
 // @Tags birthdays
 // @Produce json
 // @Security Bearer
-// @Param id path string true "Birthday ID"
-// @Success 200 {object} models.BirthdayResponse
+// @Param type query string false "Event type (GET /events/duplicates only)" Enums(birthday, wedding_anniversary, anniversary, work_anniversary, memorial, name_day, other)
+// @Success 200 {array} models.DuplicateGroupResponse
+// @Failure 400 {object} map[string]string "Invalid type parameter"
 // @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden"
-// @Failure 404 {object} map[string]string "Not found"
-// @Router /birthdays/{id} [get]
-func (h *BirthdayHandler) GetBirthdayByID(c *gin.Context) {
-	id, err := uuid.Parse(c.Param("id"))
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/duplicates [get]
+// @Router /events/duplicates [get]
+func (h *BirthdayHandler) GetDuplicateBirthdays(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	birthday, err := h.birthdayService.GetByID(id)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found"})
+	eventType, ok := eventTypeScope(c)
+	if !ok {
 		return
 	}
 
-	userID, _ := middleware.GetUserID(c)
-	if birthday.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+	groups, err := h.birthdayService.FindDuplicates(userID, h.calendar(userID), eventType)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to find duplicates"})
 		return
 	}
 
-	c.JSON(http.StatusOK, birthday.ToResponse())
+	c.JSON(http.StatusOK, groups)
 }
 
-// UpdateBirthday godoc
-// @Summary Update a birthday
-// @Description Update a birthday record (must belong to authenticated user)
+// MergeBirthdays godoc
+// @Summary Merge birthdays
+// @Description Merge one or more birthdays into a survivor and move them to the trash, in one transaction. All of them must be events of the same type.
+// @Description Name, birth date and category are taken from the birthday named in fields, defaulting to the survivor;
+// @Description a survivor without a birth year takes it from a source on the same date unless birth_date is chosen.
+// @Description Notes are concatenated (survivor first, duplicates dropped) and tags are combined.
 // @Tags birthdays
 // @Accept json
 // @Produce json
 // @Security Bearer
-// @Param id path string true "Birthday ID"
-// @Param birthday body models.CreateBirthdayRequest true "Birthday details"
-// @Success 200 {object} models.BirthdayResponse
+// @Param merge body models.MergeBirthdaysRequest true "Birthdays to merge"
+// @Success 200 {object} models.BirthdayResponse "The merged survivor"
 // @Failure 400 {object} map[string]string "Invalid request"
 // @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden"
-// @Failure 404 {object} map[string]string "Not found"
-// @Router /birthdays/{id} [put]
-func (h *BirthdayHandler) UpdateBirthday(c *gin.Context) {
-	id, err := uuid.Parse(c.Param("id"))
+// @Failure 404 {object} map[string]string "A birthday was not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/merge [post]
+func (h *BirthdayHandler) MergeBirthdays(c *gin.Context) {
+	var req models.MergeBirthdaysRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	var req models.CreateBirthdayRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+	birthday, err := h.birthdayService.MergeBirthdays(userID, &req)
+	if err != nil {
+		switch {
+		case err == service.ErrBirthdayNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found"})
+		case errors.Is(err, service.ErrInvalidMerge):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to merge birthdays"})
+		}
 		return
 	}
 
-	birthday, err := h.birthdayService.GetByID(id)
+	c.JSON(http.StatusOK, birthday.ToResponse(h.calendar(userID)))
+}
+
+// ImportBirthdaysCSV godoc
+// @Summary Import birthdays from CSV
+// @Description Import birthdays from a CSV file with a header row, using the same validation as creating a birthday.
+// @Description Columns default to name, birth_date, category and notes (matched case-insensitively) and can be mapped to other headers.
+// @Description Existing birthdays with the same name (case-insensitive) are skipped, updated or duplicated according to on_duplicate.
+// @Description With dry_run=true nothing is saved and the report shows what would happen. Files are limited to 5 MB and 5000 rows.
+// @Description Rows with an event_type other than birthday are reported as invalid on POST /birthdays/import.csv; POST /events/import.csv imports every type.
+// @Tags birthdays
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param file formData file true "CSV file"
+// @Param dry_run formData bool false "Validate and report without saving" default(false)
+// @Param on_duplicate formData string false "What to do with rows whose name matches an existing birthday" Enums(skip, update, create) default(skip)
+// @Param default_category formData string false "Category for rows without one"
+// @Param name_column formData string false "Header of the name column" default(name)
+// @Param birth_date_column formData string false "Header of the birth date column" default(birth_date)
+// @Param category_column formData string false "Header of the category column" default(category)
+// @Param notes_column formData string false "Header of the notes column" default(notes)
+// @Success 200 {object} models.ImportReport
+// @Failure 400 {object} map[string]string "Invalid file or options"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 413 {object} map[string]string "File too large"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/import.csv [post]
+// @Router /events/import.csv [post]
+func (h *BirthdayHandler) ImportBirthdaysCSV(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found"})
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	userID, _ := middleware.GetUserID(c)
-	if birthday.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+	file := h.importFile(c)
+	if file == nil {
+		return
+	}
+	defer file.Close()
+
+	var opts models.CSVImportOptions
+	if err := c.ShouldBind(&opts); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid import options: " + err.Error()})
+		return
+	}
+
+	report, err := h.birthdayService.ImportCSV(userID, c.GetString(eventTypeKey), file, &opts)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidImport) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import birthdays"})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// ImportBirthdaysVCard godoc
+// @Summary Import birthdays from vCard contacts
+// @Description Import birthdays from a vCard 3.0 or 4.0 file with one or more contacts, using the same validation as creating a birthday.
+// @Description FN (or N) becomes the name and BDAY the birth date (YYYYMMDD, YYYY-MM-DD, --MMDD or --MM-DD). The first CATEGORIES value becomes the category and the others become tags.
+// @Description Contacts without BDAY are skipped. Existing birthdays with the same name (case-insensitive) are skipped, updated or duplicated according to on_duplicate.
+// @Description With dry_run=true nothing is saved and the report shows what would happen. Files are limited to 5 MB and 5000 contacts.
+// @Description POST /birthdays/import.vcf and POST /events/import.vcf behave the same, as contacts are always imported as birthdays.
+// @Tags birthdays
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param file formData file true "vCard file"
+// @Param dry_run formData bool false "Validate and report without saving" default(false)
+// @Param on_duplicate formData string false "What to do with contacts whose name matches an existing birthday" Enums(skip, update, create) default(skip)
+// @Param default_category formData string false "Category for contacts without CATEGORIES"
+// @Success 200 {object} models.ImportReport
+// @Failure 400 {object} map[string]string "Invalid file or options"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 413 {object} map[string]string "File too large"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/import.vcf [post]
+// @Router /events/import.vcf [post]
+func (h *BirthdayHandler) ImportBirthdaysVCard(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	parts := strings.Split(req.BirthDate, "-")
-	if len(parts) != 2 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birth date format"})
+	file := h.importFile(c)
+	if file == nil {
 		return
 	}
+	defer file.Close()
 
-	month, err := strconv.Atoi(parts[0])
-	if err != nil || month < 1 || month > 12 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid month"})
+	var opts models.ImportOptions
+	if err := c.ShouldBind(&opts); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid import options: " + err.Error()})
 		return
 	}
 
-	day, err := strconv.Atoi(parts[1])
-	if err != nil || day < 1 || day > 31 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid day"})
+	report, err := h.birthdayService.ImportVCard(userID, c.GetString(eventTypeKey), file, &opts)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidImport) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import birthdays"})
 		return
 	}
 
-	birthday.Name = req.Name
-	birthday.BirthMonth = month
-	birthday.BirthDay = day
-	birthday.Notes = req.Notes
+	c.JSON(http.StatusOK, report)
+}
 
-	if err := h.birthdayService.Update(birthday); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update birthday"})
+// ImportBirthdaysICS godoc
+// @Summary Import birthdays from an iCalendar file
+// @Description Import yearly recurring events (RRULE:FREQ=YEARLY) from an .ics file exported by another calendar, using the same validation as creating a birthday.
+// @Description SUMMARY becomes the name (a trailing "'s birthday" is removed), DTSTART the birth date and DESCRIPTION the notes. The first CATEGORIES value becomes the category and the others become tags.
+// @Description Events are remembered by UID, so importing the same file again skips them (or updates them with on_duplicate=update) instead of creating duplicates.
+// @Description Events without a known UID are matched by name like the other imports; other events are skipped. Files are limited to 5 MB and 5000 events.
+// @Description Anniversaries, memorials and other events recognised from SUMMARY are reported as invalid on POST /birthdays/import.ics; POST /events/import.ics imports every type.
+// @Tags birthdays
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param file formData file true "iCalendar file"
+// @Param dry_run formData bool false "Validate and report without saving" default(false)
+// @Param on_duplicate formData string false "What to do with events that were imported before or whose name matches an existing birthday" Enums(skip, update, create) default(skip)
+// @Param default_category formData string false "Category for events without CATEGORIES"
+// @Param ignore_year formData bool false "Treat every birth year as unknown, for calendars that start the series when the event was created" default(false)
+// @Success 200 {object} models.ImportReport
+// @Failure 400 {object} map[string]string "Invalid file or options"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 413 {object} map[string]string "File too large"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/import.ics [post]
+// @Router /events/import.ics [post]
+func (h *BirthdayHandler) ImportBirthdaysICS(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	file := h.importFile(c)
+	if file == nil {
 		return
 	}
+	defer file.Close()
 
-	c.JSON(http.StatusOK, birthday.ToResponse())
+	var opts models.ICSImportOptions
+	if err := c.ShouldBind(&opts); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid import options: " + err.Error()})
+		return
+	}
+
+	report, err := h.birthdayService.ImportICS(userID, c.GetString(eventTypeKey), file, &opts)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidImport) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import birthdays"})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
 }
 
-// DeleteBirthday godoc
-// @Summary Delete a birthday
-// @Description Delete a birthday record (must belong to authenticated user)
+// importFile opens the uploaded "file" form field, limiting the request body
+// to maxImportSize. It writes the error response and returns nil when there
+// is no usable file.
+func (h *BirthdayHandler) importFile(c *gin.Context) multipart.File {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Import file must not exceed 5 MB"})
+			return nil
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A file must be uploaded in the file field"})
+		return nil
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read uploaded file"})
+		return nil
+	}
+	return file
+}
+
+// ExportBirthdaysCSV godoc
+// @Summary Export birthdays as CSV
+// @Description Download all birthdays of the authenticated user as CSV with the columns name, birth_date, category, notes, event_type and partner_name, ordered by name.
+// @Description GET /events/export.csv exports events of every type, or of the given type.
+// @Description Cells that spreadsheets would evaluate as formulas are prefixed with a single quote; the import removes it again.
+// @Tags birthdays
+// @Produce text/csv
+// @Security Bearer
+// @Param type query string false "Event type (GET /events/export.csv only)" Enums(birthday, wedding_anniversary, anniversary, work_anniversary, memorial, name_day, other)
+// @Success 200 {file} file "CSV file"
+// @Failure 400 {object} map[string]string "Invalid type parameter"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/export.csv [get]
+// @Router /events/export.csv [get]
+func (h *BirthdayHandler) ExportBirthdaysCSV(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	eventType, ok := eventTypeScope(c)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := h.birthdayService.ExportCSV(userID, eventType, &buf); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export birthdays"})
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="birthdays.csv"`)
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+// GetUserBirthdays godoc
+// @Summary Get user's birthdays
+// @Description Get a page of birthdays for the authenticated user, optionally filtered and sorted.
+// @Description Pass the returned next_cursor as the cursor parameter to fetch the following page.
+// @Description GET /birthdays lists birthdays only; GET /events lists events of every type, or of the given type.
 // @Tags birthdays
 // @Produce json
 // @Security Bearer
-// @Param id path string true "Birthday ID"
-// @Success 200 {object} map[string]string "Success message"
-// @Failure 400 {object} map[string]string "Invalid request"
+// @Param type query string false "Filter by event type (GET /events only)" Enums(birthday, wedding_anniversary, anniversary, work_anniversary, memorial, name_day, other)
+// @Param category query string false "Filter by category (case-insensitive)"
+// @Param month query int false "Filter by birth month (1-12)"
+// @Param name query string false "Filter by name prefix (case-insensitive)"
+// @Param q query string false "Search name, notes, phone numbers, emails, addresses and social handles (case-insensitive substring; phone numbers match regardless of formatting)"
+// @Param tags query []string false "Filter by tags (comma-separated or repeated)" collectionFormat(csv)
+// @Param tag_mode query string false "Whether birthdays must carry any or all of the tags" Enums(any, all) default(any)
+// @Param sort query string false "Sort order" Enums(next, name, -name, created_at, -created_at) default(next)
+// @Param limit query int false "Page size (1-100)" default(50)
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Success 200 {object} models.BirthdayListResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
 // @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden"
-// @Failure 404 {object} map[string]string "Not found"
-// @Router /birthdays/{id} [delete]
-func (h *BirthdayHandler) DeleteBirthday(c *gin.Context) {
-	id, err := uuid.Parse(c.Param("id"))
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays [get]
+// @Router /events [get]
+func (h *BirthdayHandler) GetUserBirthdays(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	birthday, err := h.birthdayService.GetByID(id)
+	var query models.ListBirthdaysQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	eventType, ok := eventTypeScope(c)
+	if !ok {
+		return
+	}
+	query.Type = eventType
+
+	cal := h.calendar(userID)
+	birthdays, nextCursor, err := h.birthdayService.ListBirthdays(userID, cal, &query)
+	if err == service.ErrInvalidCursor || err == service.ErrInvalidTag || err == service.ErrTagTooLong {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch birthdays"})
 		return
 	}
 
-	userID, _ := middleware.GetUserID(c)
-	if birthday.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+	response := models.BirthdayListResponse{Items: make([]*models.BirthdayResponse, len(birthdays))}
+	for i, birthday := range birthdays {
+		response.Items[i] = birthday.ToResponse(cal)
+	}
+	if nextCursor != "" {
+		response.NextCursor = &nextCursor
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetUpcomingBirthdays godoc
+// @Summary Get upcoming birthdays
+// @Description Get the authenticated user's birthdays occurring within the next N days, ordered by next occurrence.
+// @Description Dates are computed in the user's timezone, wrap from December to January and follow the user's leap-day policy.
+// @Description With group_by=household the birthdays are grouped by household instead (response: array of models.UpcomingHouseholdResponse), groups ordered by their earliest birthday; birthdays without a household form one group with a null household_id.
+// @Description GET /events/upcoming includes events of every type, or of the given type.
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param days query int false "Window size in days, including today (1-366)" default(30)
+// @Param group_by query string false "Group the birthdays" Enums(household)
+// @Param type query string false "Event type (GET /events/upcoming only)" Enums(birthday, wedding_anniversary, anniversary, work_anniversary, memorial, name_day, other)
+// @Success 200 {array} models.UpcomingBirthdayResponse
+// @Failure 400 {object} map[string]string "Invalid days, group_by or type parameter"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/upcoming [get]
+// @Router /events/upcoming [get]
+func (h *BirthdayHandler) GetUpcomingBirthdays(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	if err := h.birthdayService.Delete(id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete birthday"})
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil || days < 1 || days > service.MaxUpcomingDays {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid days parameter"})
+		return
+	}
+
+	groupBy := c.Query("group_by")
+	if groupBy != "" && groupBy != "household" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group_by parameter"})
+		return
+	}
+
+	eventType, ok := eventTypeScope(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.userService.GetUserByID(userID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+		return
+	}
+
+	upcoming, err := h.birthdayService.GetUpcoming(userID, user.Calendar(time.Now()), eventType, days)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch upcoming birthdays"})
+		return
+	}
+
+	if groupBy == "household" {
+		groups, err := h.householdService.GroupUpcoming(userID, upcoming)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch households"})
+			return
+		}
+		c.JSON(http.StatusOK, groups)
+		return
+	}
+
+	c.JSON(http.StatusOK, upcoming)
+}
+
+// GetBirthdayCategories godoc
+// @Summary Get birthday counts per category
+// @Description Get every category of the authenticated user with its number of birthdays and the next upcoming birthday in it.
+// @Description Use GET /birthdays?category=X to list the birthdays of a single category.
+// @Description GET /events/categories counts events of every type, or of the given type.
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param type query string false "Event type (GET /events/categories only)" Enums(birthday, wedding_anniversary, anniversary, work_anniversary, memorial, name_day, other)
+// @Success 200 {array} models.CategoryStatsResponse
+// @Failure 400 {object} map[string]string "Invalid type parameter"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/categories [get]
+// @Router /events/categories [get]
+func (h *BirthdayHandler) GetBirthdayCategories(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	eventType, ok := eventTypeScope(c)
+	if !ok {
+		return
+	}
+
+	stats, err := h.birthdayService.GetCategoryStats(userID, h.calendar(userID), eventType)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch categories"})
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+// GetEventTypes godoc
+// @Summary List event types
+// @Description List the kinds of yearly recurring events with their icon and the wording of their years (e.g. "age" for birthdays, "years married" for wedding anniversaries).
+// @Description Couple types take a partner_name for the second person; years are not counted for name days.
+// @Tags events
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} models.EventType
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Router /events/types [get]
+func (h *BirthdayHandler) GetEventTypes(c *gin.Context) {
+	c.JSON(http.StatusOK, models.EventTypes)
+}
+
+// GetBirthdayByID godoc
+// @Summary Get a birthday by ID
+// @Description Get a birthday record by its ID (must belong to authenticated user)
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Success 200 {object} models.BirthdayResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /birthdays/{id} [get]
+func (h *BirthdayHandler) GetBirthdayByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		return
+	}
+
+	birthday, err := h.birthdayService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found"})
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if birthday.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return
+	}
+
+	c.JSON(http.StatusOK, birthday.ToResponse(h.calendar(userID)))
+}
+
+// GetBirthdayObservances godoc
+// @Summary Get observance dates of a birthday
+// @Description Get the date a birthday is effectively observed in each year of a range, following the user's leap-day policy.
+// @Description Feb 29 birthdays are observed on Feb 28, Mar 1 or skipped in non-leap years depending on the policy.
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param from query int false "First year of the range (defaults to the current year)"
+// @Param to query int false "Last year of the range (defaults to from + 4)"
+// @Success 200 {array} models.ObservanceResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /birthdays/{id}/observances [get]
+func (h *BirthdayHandler) GetBirthdayObservances(c *gin.Context) {
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	cal := h.calendar(birthday.UserID)
+	from, err := strconv.Atoi(c.DefaultQuery("from", strconv.Itoa(cal.Today.Year())))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from year"})
+		return
+	}
+
+	to, err := strconv.Atoi(c.DefaultQuery("to", strconv.Itoa(from+4)))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to year"})
+		return
+	}
+
+	observances, err := h.birthdayService.GetObservances(birthday, cal.LeapDayPolicy, from, to)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, observances)
+}
+
+// AddBirthdayTags godoc
+// @Summary Add tags to a birthday
+// @Description Attach one or more tags to a birthday (must belong to authenticated user). Unknown tags are created.
+// @Tags birthdays
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param tags body models.TagBirthdayRequest true "Tags to add"
+// @Success 200 {object} models.BirthdayResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /birthdays/{id}/tags [post]
+func (h *BirthdayHandler) AddBirthdayTags(c *gin.Context) {
+	var req models.TagBirthdayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if err := h.birthdayService.AddTags(userID, birthday, req.Tags); err != nil {
+		if err == service.ErrInvalidTag || err == service.ErrTagTooLong {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add tags"})
+		return
+	}
+
+	birthday, err := h.birthdayService.GetByID(birthday.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch birthday"})
+		return
+	}
+
+	c.JSON(http.StatusOK, birthday.ToResponse(h.calendar(birthday.UserID)))
+}
+
+// RemoveBirthdayTag godoc
+// @Summary Remove a tag from a birthday
+// @Description Detach a tag from a birthday (must belong to authenticated user). The tag itself is kept.
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param tag path string true "Tag name"
+// @Success 200 {object} models.BirthdayResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /birthdays/{id}/tags/{tag} [delete]
+func (h *BirthdayHandler) RemoveBirthdayTag(c *gin.Context) {
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if err := h.birthdayService.RemoveTag(userID, birthday, c.Param("tag")); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove tag"})
+		return
+	}
+
+	c.JSON(http.StatusOK, birthday.ToResponse(h.calendar(birthday.UserID)))
+}
+
+// UpdateBirthday godoc
+// @Summary Update a birthday
+// @Description Replace a birthday record (must belong to authenticated user). Tags are only replaced when present.
+// @Tags birthdays
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param birthday body models.CreateBirthdayRequest true "Birthday details"
+// @Success 200 {object} models.BirthdayResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id} [put]
+func (h *BirthdayHandler) UpdateBirthday(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		return
+	}
+
+	var req models.CreateBirthdayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	birthday, err := h.birthdayService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found"})
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if birthday.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return
+	}
+
+	birthday, err = h.birthdayService.UpdateBirthday(userID, birthday, &req)
+	if errors.Is(err, service.ErrInvalidBirthday) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update birthday"})
+		return
+	}
+
+	c.JSON(http.StatusOK, birthday.ToResponse(h.calendar(userID)))
+}
+
+// PatchBirthday godoc
+// @Summary Partially update a birthday
+// @Description Apply an RFC 7386 JSON merge patch to a birthday record (must belong to authenticated user).
+// @Description Only the fields present in the document are changed. null clears notes, partner_name, tags and contact detail lists;
+// @Description name, birth_date, event_type and category cannot be removed. tags, when present, replaces the full tag list.
+// @Tags birthdays
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param patch body models.PatchBirthdayRequest true "Merge patch document"
+// @Success 200 {object} models.BirthdayResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 415 {object} map[string]string "Unsupported content type"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id} [patch]
+func (h *BirthdayHandler) PatchBirthday(c *gin.Context) {
+	switch c.ContentType() {
+	case "application/merge-patch+json", "application/json":
+	default:
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content-Type must be application/merge-patch+json"})
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := c.ShouldBindJSON(&patch); err != nil || patch == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Merge patch must be a JSON object"})
+		return
+	}
+
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	birthday, err := h.birthdayService.PatchBirthday(userID, birthday, patch)
+	if errors.Is(err, service.ErrInvalidBirthday) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update birthday"})
+		return
+	}
+
+	c.JSON(http.StatusOK, birthday.ToResponse(h.calendar(birthday.UserID)))
+}
+
+// DeleteBirthday godoc
+// @Summary Delete a birthday
+// @Description Move a birthday record to the trash (must belong to authenticated user).
+// @Description It can be restored until it is permanently deleted after the trash retention period.
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /birthdays/{id} [delete]
+func (h *BirthdayHandler) DeleteBirthday(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		return
+	}
+
+	birthday, err := h.birthdayService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found"})
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if birthday.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return
+	}
+
+	if err := h.birthdayService.Delete(userID, birthday); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete birthday"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Birthday moved to trash"})
+}
+
+// GetTrashedBirthdays godoc
+// @Summary List deleted birthdays
+// @Description List the authenticated user's birthdays in the trash, most recently deleted first, with the time each one is permanently deleted.
+// @Description GET /events/trash lists deleted events of every type, or of the given type.
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param type query string false "Event type (GET /events/trash only)" Enums(birthday, wedding_anniversary, anniversary, work_anniversary, memorial, name_day, other)
+// @Success 200 {array} models.TrashedBirthdayResponse
+// @Failure 400 {object} map[string]string "Invalid type parameter"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/trash [get]
+// @Router /events/trash [get]
+func (h *BirthdayHandler) GetTrashedBirthdays(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	eventType, ok := eventTypeScope(c)
+	if !ok {
+		return
+	}
+
+	birthdays, err := h.birthdayService.GetTrash(userID, eventType)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch trash"})
+		return
+	}
+
+	cal := h.calendar(userID)
+	response := make([]*models.TrashedBirthdayResponse, len(birthdays))
+	for i := range birthdays {
+		response[i] = birthdays[i].ToTrashedResponse(cal, h.birthdayService.TrashRetention())
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RestoreBirthday godoc
+// @Summary Restore a deleted birthday
+// @Description Take a birthday out of the trash (must belong to authenticated user). Its category is created again if it was deleted in the meantime.
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Success 200 {object} models.BirthdayResponse
+// @Failure 400 {object} map[string]string "Invalid birthday ID"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not in the trash"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/restore [post]
+func (h *BirthdayHandler) RestoreBirthday(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		return
+	}
+
+	birthday, err := h.birthdayService.GetTrashedByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found in trash"})
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if birthday.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return
+	}
+
+	if err := h.birthdayService.Restore(userID, birthday); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore birthday"})
+		return
+	}
+
+	c.JSON(http.StatusOK, birthday.ToResponse(h.calendar(userID)))
+}
+
+// GetBirthdayHistory godoc
+// @Summary Get the revision history of a birthday
+// @Description List every recorded change of a birthday (must belong to authenticated user), newest first: creation, updates with a field-level diff, deletion, restore, reverts and merges.
+// @Description Each revision includes the acting user and a snapshot of the birthday after the change. Birthdays in the trash keep their history.
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Success 200 {array} models.BirthdayRevisionResponse
+// @Failure 400 {object} map[string]string "Invalid birthday ID"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/history [get]
+func (h *BirthdayHandler) GetBirthdayHistory(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		return
+	}
+
+	birthday, err := h.birthdayService.GetByID(id)
+	if err != nil {
+		birthday, err = h.birthdayService.GetTrashedByID(id)
+	}
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found"})
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if birthday.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return
+	}
+
+	revisions, err := h.birthdayService.GetHistory(birthday.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch history"})
+		return
+	}
+
+	response := make([]*models.BirthdayRevisionResponse, len(revisions))
+	for i := range revisions {
+		response[i] = revisions[i].ToResponse()
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RevertBirthday godoc
+// @Summary Revert a birthday to an earlier revision
+// @Description Restore the name, birth date, category, notes, tags and contact details a birthday had after one of its revisions (must belong to authenticated user).
+// @Description The revert is recorded as a new revision; deleted birthdays must be restored from the trash first.
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param revision path string true "Revision ID"
+// @Success 200 {object} models.BirthdayResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Birthday or revision not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/revert/{revision} [post]
+func (h *BirthdayHandler) RevertBirthday(c *gin.Context) {
+	revisionID, err := uuid.Parse(c.Param("revision"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid revision ID"})
+		return
+	}
+
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	birthday, err = h.birthdayService.Revert(userID, birthday, revisionID)
+	if err != nil {
+		switch {
+		case err == service.ErrRevisionNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, service.ErrInvalidBirthday):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revert birthday"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, birthday.ToResponse(h.calendar(userID)))
+}
+
+// UploadBirthdayPhoto godoc
+// @Summary Upload a birthday photo
+// @Description Attach a photo to a birthday (must belong to authenticated user), replacing the current one.
+// @Description JPEG, PNG and GIF images up to 10 MB and 40 megapixels are accepted; the format is detected from the content, not the file name.
+// @Description The image is turned upright according to its EXIF orientation, scaled down to at most 2048 pixels per side and re-encoded, which removes EXIF and other metadata.
+// @Description JPEGs are stored as JPEG, PNGs and GIFs (first frame) as PNG. A 256x256 thumbnail cropped from the center is generated as well.
+// @Tags birthdays
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param photo formData file true "Image file"
+// @Success 200 {object} models.BirthdayPhotoResponse
+// @Failure 400 {object} map[string]string "Invalid request or image"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 413 {object} map[string]string "Photo too large"
+// @Failure 415 {object} map[string]string "Unsupported image format"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/photo [put]
+func (h *BirthdayHandler) UploadBirthdayPhoto(c *gin.Context) {
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxPhotoSize)
+	header, err := c.FormFile("photo")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Photo must not exceed 10 MB"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "An image must be uploaded in the photo field"})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read uploaded file"})
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read uploaded file"})
+		return
+	}
+
+	photo, err := h.photoService.Upload(birthday.ID, data)
+	switch err {
+	case nil:
+	case service.ErrUnsupportedPhoto:
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		return
+	case service.ErrPhotoTooLarge:
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		return
+	case service.ErrInvalidPhoto:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save photo"})
+		return
+	}
+
+	c.JSON(http.StatusOK, photo.ToResponse())
+}
+
+// GetBirthdayPhoto godoc
+// @Summary Download a birthday photo
+// @Description Download the photo of a birthday (must belong to authenticated user)
+// @Tags birthdays
+// @Produce image/jpeg,image/png
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Success 200 {file} file "Photo"
+// @Success 304 "Not modified"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /birthdays/{id}/photo [get]
+func (h *BirthdayHandler) GetBirthdayPhoto(c *gin.Context) {
+	h.servePhoto(c, false)
+}
+
+// GetBirthdayPhotoThumbnail godoc
+// @Summary Download a birthday photo thumbnail
+// @Description Download the 256x256 thumbnail of a birthday's photo (must belong to authenticated user)
+// @Tags birthdays
+// @Produce image/jpeg,image/png
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Success 200 {file} file "Thumbnail"
+// @Success 304 "Not modified"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /birthdays/{id}/photo/thumbnail [get]
+func (h *BirthdayHandler) GetBirthdayPhotoThumbnail(c *gin.Context) {
+	h.servePhoto(c, true)
+}
+
+// servePhoto writes the photo of the birthday named by the :id path
+// parameter, or its thumbnail. Uploads are stored under new keys, so the
+// photo ID serves as ETag.
+func (h *BirthdayHandler) servePhoto(c *gin.Context, thumbnail bool) {
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+	photo := birthday.Photo
+	if photo == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Photo not found"})
+		return
+	}
+
+	etag := `"` + photo.ID.String() + `"`
+	if thumbnail {
+		etag = `"` + photo.ID.String() + `-thumb"`
+	}
+	c.Header("Cache-Control", "private, max-age=86400")
+	c.Header("ETag", etag)
+	c.Header("X-Content-Type-Options", "nosniff")
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	r, err := h.photoService.Open(photo, thumbnail)
+	if err != nil {
+		if err == service.ErrPhotoNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Photo not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load photo"})
+		return
+	}
+	defer r.Close()
+
+	size := photo.Size
+	if thumbnail {
+		size = -1
+	}
+	c.DataFromReader(http.StatusOK, size, photo.ContentType, r, nil)
+}
+
+// DeleteBirthdayPhoto godoc
+// @Summary Delete a birthday photo
+// @Description Remove the photo and thumbnail of a birthday (must belong to authenticated user)
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/photo [delete]
+func (h *BirthdayHandler) DeleteBirthdayPhoto(c *gin.Context) {
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	switch err := h.photoService.Delete(birthday.ID); err {
+	case nil:
+	case service.ErrPhotoNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Photo not found"})
+		return
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete photo"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Photo deleted successfully"})
+}
+
+// GetBirthdayRelationships godoc
+// @Summary Get relatives of a birthday
+// @Description Get the people a birthday is related to (must belong to authenticated user), ordered by name. Relatives in the trash are left out.
+// @Description The type names the relative's role, e.g. child when the relative is this person's child.
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Success 200 {array} models.RelationshipResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/relationships [get]
+func (h *BirthdayHandler) GetBirthdayRelationships(c *gin.Context) {
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	relationships, err := h.birthdayService.GetRelationships(birthday.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch relationships"})
+		return
+	}
+
+	response := make([]*models.RelationshipResponse, len(relationships))
+	for i := range relationships {
+		response[i] = relationships[i].ToResponse()
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// AddBirthdayRelationship godoc
+// @Summary Relate two birthdays
+// @Description Relate a birthday to another birthday of the authenticated user. Relationships are bidirectional: the related birthday gets the inverse relationship,
+// @Description so adding Anna as John's child also lists John as Anna's parent. Two birthdays can be related in one way only.
+// @Tags birthdays
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param relationship body models.CreateRelationshipRequest true "Related birthday and type"
+// @Success 201 {object} models.RelationshipResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Birthday or related birthday not found"
+// @Failure 409 {object} map[string]string "Birthdays are already related"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/relationships [post]
+func (h *BirthdayHandler) AddBirthdayRelationship(c *gin.Context) {
+	var req models.CreateRelationshipRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	relationship, err := h.birthdayService.AddRelationship(birthday, &req)
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrInvalidRelationship):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case err == service.ErrBirthdayNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Related birthday not found"})
+		return
+	case err == service.ErrRelationshipExists:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add relationship"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, relationship.ToResponse())
+}
+
+// RemoveBirthdayRelationship godoc
+// @Summary Remove a relationship
+// @Description Remove a relationship from a birthday (must belong to authenticated user), in both directions. The related birthday is kept.
+// @Tags birthdays
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param relationship path string true "Relationship ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Birthday or relationship not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/relationships/{relationship} [delete]
+func (h *BirthdayHandler) RemoveBirthdayRelationship(c *gin.Context) {
+	relationshipID, err := uuid.Parse(c.Param("relationship"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid relationship ID"})
+		return
+	}
+
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	err = h.birthdayService.RemoveRelationship(birthday, relationshipID)
+	if err == service.ErrRelationshipNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove relationship"})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Birthday deleted successfully"})
+	c.JSON(http.StatusOK, gin.H{"message": "Relationship removed successfully"})
 }
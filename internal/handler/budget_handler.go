@@ -0,0 +1,298 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/middleware"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/service"
+)
+
+type BudgetHandler struct {
+	budgetService *service.BudgetService
+	userService   *service.UserService
+}
+
+func NewBudgetHandler(budgetService *service.BudgetService, userService *service.UserService) *BudgetHandler {
+	return &BudgetHandler{
+		budgetService: budgetService,
+		userService:   userService,
+	}
+}
+
+func (h *BudgetHandler) RegisterRoutes(r *gin.Engine) {
+	api := r.Group("/api/v1")
+	budgets := api.Group("/budgets")
+	budgets.Use(middleware.JWTAuth(func() []byte {
+		return h.userService.GetJWTSecret()
+	}))
+	{
+		budgets.POST("", h.CreateBudget)
+		budgets.GET("", h.GetUserBudgets)
+		budgets.GET("/:id", h.GetBudgetByID)
+		budgets.PUT("/:id", h.UpdateBudget)
+		budgets.DELETE("/:id", h.DeleteBudget)
+	}
+
+	reports := api.Group("/reports")
+	reports.Use(middleware.JWTAuth(func() []byte {
+		return h.userService.GetJWTSecret()
+	}))
+	{
+		reports.GET("/spending", h.GetSpendingReport)
+	}
+}
+
+// ownedBudget loads the budget named by the :id path parameter and checks
+// that it belongs to the authenticated user. It writes the error response and
+// returns nil when the budget cannot be used.
+func (h *BudgetHandler) ownedBudget(c *gin.Context) *models.Budget {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid budget ID"})
+		return nil
+	}
+
+	budget, err := h.budgetService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Budget not found"})
+		return nil
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if budget.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return nil
+	}
+
+	return budget
+}
+
+// budgetError writes the response for an error returned when saving a budget
+func budgetError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrInvalidBudget):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case err == service.ErrCategoryNotFound, err == service.ErrBirthdayNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case err == service.ErrBudgetExists:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+	}
+}
+
+// CreateBudget godoc
+// @Summary Create a gift budget
+// @Description Create a gift budget for a year: a yearly budget when neither category_id nor birthday_id is given, otherwise a budget for one category or one person.
+// @Description There is at most one budget per scope, year and currency.
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param budget body models.CreateBudgetRequest true "Budget details"
+// @Success 201 {object} models.BudgetResponse
+// @Failure 400 {object} map[string]string "Invalid request body"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 404 {object} map[string]string "Category or birthday not found"
+// @Failure 409 {object} map[string]string "Budget already exists"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /budgets [post]
+func (h *BudgetHandler) CreateBudget(c *gin.Context) {
+	var req models.CreateBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	budget, err := h.budgetService.Create(userID, &req)
+	if err != nil {
+		budgetError(c, err, "Failed to create budget")
+		return
+	}
+
+	c.JSON(http.StatusCreated, budget.ToResponse())
+}
+
+// GetUserBudgets godoc
+// @Summary Get user's gift budgets
+// @Description Get the gift budgets of the authenticated user, ordered by year with yearly budgets first, then category and birthday budgets
+// @Tags budgets
+// @Produce json
+// @Security Bearer
+// @Param year query int false "Only budgets for this year"
+// @Success 200 {array} models.BudgetResponse
+// @Failure 400 {object} map[string]string "Invalid year"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /budgets [get]
+func (h *BudgetHandler) GetUserBudgets(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	year, err := strconv.Atoi(c.DefaultQuery("year", "0"))
+	if err != nil || year < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid year"})
+		return
+	}
+
+	budgets, err := h.budgetService.GetByUserID(userID, year)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch budgets"})
+		return
+	}
+
+	response := make([]*models.BudgetResponse, len(budgets))
+	for i := range budgets {
+		response[i] = budgets[i].ToResponse()
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetBudgetByID godoc
+// @Summary Get a gift budget by ID
+// @Description Get a gift budget by its ID (must belong to authenticated user)
+// @Tags budgets
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Budget ID"
+// @Success 200 {object} models.BudgetResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /budgets/{id} [get]
+func (h *BudgetHandler) GetBudgetByID(c *gin.Context) {
+	budget := h.ownedBudget(c)
+	if budget == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, budget.ToResponse())
+}
+
+// UpdateBudget godoc
+// @Summary Update a gift budget
+// @Description Replace the year, scope, amount and currency of a gift budget (must belong to authenticated user)
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Budget ID"
+// @Param budget body models.CreateBudgetRequest true "Budget details"
+// @Success 200 {object} models.BudgetResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Budget, category or birthday not found"
+// @Failure 409 {object} map[string]string "Budget already exists"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /budgets/{id} [put]
+func (h *BudgetHandler) UpdateBudget(c *gin.Context) {
+	var req models.CreateBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	budget := h.ownedBudget(c)
+	if budget == nil {
+		return
+	}
+
+	if err := h.budgetService.Update(budget, &req); err != nil {
+		budgetError(c, err, "Failed to update budget")
+		return
+	}
+
+	c.JSON(http.StatusOK, budget.ToResponse())
+}
+
+// DeleteBudget godoc
+// @Summary Delete a gift budget
+// @Description Delete a gift budget (must belong to authenticated user)
+// @Tags budgets
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Budget ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /budgets/{id} [delete]
+func (h *BudgetHandler) DeleteBudget(c *gin.Context) {
+	budget := h.ownedBudget(c)
+	if budget == nil {
+		return
+	}
+
+	if err := h.budgetService.Delete(budget.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete budget"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Budget deleted successfully"})
+}
+
+// GetSpendingReport godoc
+// @Summary Get the gift spending report
+// @Description Compare the gift budgets of a year with the estimated and actual prices of the gifts for the birthdays in that year, in total and broken down by month, category and person.
+// @Description Given gifts count towards their given_year, gifts not given yet towards the birthday's next occurrence. Amounts in different currencies are reported separately, never converted.
+// @Tags reports
+// @Produce json
+// @Security Bearer
+// @Param year query int false "Year of the report (defaults to the current year)"
+// @Success 200 {object} models.SpendingReportResponse
+// @Failure 400 {object} map[string]string "Invalid year"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /reports/spending [get]
+func (h *BudgetHandler) GetSpendingReport(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	user, err := h.userService.GetUserByID(userID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+		return
+	}
+	cal := user.Calendar(time.Now())
+
+	year, err := strconv.Atoi(c.DefaultQuery("year", strconv.Itoa(cal.Today.Year())))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid year"})
+		return
+	}
+
+	report, err := h.budgetService.SpendingReport(userID, cal, year)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidBudget) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build spending report"})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
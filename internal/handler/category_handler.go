@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/middleware"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/service"
+)
+
+type CategoryHandler struct {
+	categoryService *service.CategoryService
+	userService     *service.UserService
+}
+
+func NewCategoryHandler(categoryService *service.CategoryService, userService *service.UserService) *CategoryHandler {
+	return &CategoryHandler{
+		categoryService: categoryService,
+		userService:     userService,
+	}
+}
+
+func (h *CategoryHandler) RegisterRoutes(r *gin.Engine) {
+	api := r.Group("/api/v1")
+	categories := api.Group("/categories")
+	categories.Use(middleware.JWTAuth(func() []byte {
+		return h.userService.GetJWTSecret()
+	}))
+	{
+		categories.POST("", h.CreateCategory)
+		categories.GET("", h.GetUserCategories)
+		categories.GET("/:id", h.GetCategoryByID)
+		categories.PUT("/:id", h.UpdateCategory)
+		categories.DELETE("/:id", h.DeleteCategory)
+		categories.POST("/:id/merge", h.MergeCategories)
+	}
+}
+
+// ownedCategory loads the category named by the :id path parameter and checks
+// that it belongs to the authenticated user. It writes the error response and
+// returns nil when the category cannot be used.
+func (h *CategoryHandler) ownedCategory(c *gin.Context) *models.Category {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid category ID"})
+		return nil
+	}
+
+	category, err := h.categoryService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Category not found"})
+		return nil
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if category.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return nil
+	}
+
+	return category
+}
+
+// CreateCategory godoc
+// @Summary Create a new category
+// @Description Create a new birthday category for the authenticated user. Names are unique per user, case-insensitively.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param category body models.CreateCategoryRequest true "Category details"
+// @Success 201 {object} models.CategoryResponse
+// @Failure 400 {object} map[string]string "Invalid request body"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 409 {object} map[string]string "Category already exists"
+// @Router /categories [post]
+func (h *CategoryHandler) CreateCategory(c *gin.Context) {
+	var req models.CreateCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	category, err := h.categoryService.CreateCategory(userID, &req)
+	if err == service.ErrCategoryExists {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, category.ToResponse())
+}
+
+// GetUserCategories godoc
+// @Summary Get user's categories
+// @Description Get all categories of the authenticated user, ordered by sort order and name
+// @Tags categories
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} models.CategoryResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /categories [get]
+func (h *CategoryHandler) GetUserCategories(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	categories, err := h.categoryService.GetByUserID(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch categories"})
+		return
+	}
+
+	response := make([]*models.CategoryResponse, len(categories))
+	for i, category := range categories {
+		response[i] = category.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetCategoryByID godoc
+// @Summary Get a category by ID
+// @Description Get a category by its ID (must belong to authenticated user)
+// @Tags categories
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Category ID"
+// @Success 200 {object} models.CategoryResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /categories/{id} [get]
+func (h *CategoryHandler) GetCategoryByID(c *gin.Context) {
+	category := h.ownedCategory(c)
+	if category == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, category.ToResponse())
+}
+
+// UpdateCategory godoc
+// @Summary Update a category
+// @Description Update a category (must belong to authenticated user). Renaming updates every birthday in the category atomically.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Category ID"
+// @Param category body models.CreateCategoryRequest true "Category details"
+// @Success 200 {object} models.CategoryResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 409 {object} map[string]string "Another category already has this name"
+// @Router /categories/{id} [put]
+func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
+	var req models.CreateCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	category := h.ownedCategory(c)
+	if category == nil {
+		return
+	}
+
+	category, err := h.categoryService.UpdateCategory(category, &req)
+	if err == service.ErrCategoryExists {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, category.ToResponse())
+}
+
+// DeleteCategory godoc
+// @Summary Delete a category
+// @Description Delete an empty category (must belong to authenticated user). Categories that still have birthdays must be merged instead.
+// @Tags categories
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Category ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 409 {object} map[string]string "Category still has birthdays"
+// @Router /categories/{id} [delete]
+func (h *CategoryHandler) DeleteCategory(c *gin.Context) {
+	category := h.ownedCategory(c)
+	if category == nil {
+		return
+	}
+
+	err := h.categoryService.DeleteCategory(category)
+	if err == service.ErrCategoryInUse {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete category"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Category deleted successfully"})
+}
+
+// MergeCategories godoc
+// @Summary Merge categories
+// @Description Move every birthday of the source categories into this category and delete the sources, in one transaction
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Target category ID"
+// @Param merge body models.MergeCategoriesRequest true "Categories to merge"
+// @Success 200 {object} models.CategoryResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /categories/{id}/merge [post]
+func (h *CategoryHandler) MergeCategories(c *gin.Context) {
+	var req models.MergeCategoriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	category := h.ownedCategory(c)
+	if category == nil {
+		return
+	}
+
+	err := h.categoryService.MergeCategories(category, req.SourceIDs)
+	switch err {
+	case nil:
+	case service.ErrMergeIntoItself:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case service.ErrCategoryNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Source category not found"})
+		return
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to merge categories"})
+		return
+	}
+
+	c.JSON(http.StatusOK, category.ToResponse())
+}
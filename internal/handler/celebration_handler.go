@@ -0,0 +1,682 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/config"
+	"github.com/murathanje/birthday_tracking_backend/internal/middleware"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/service"
+)
+
+type CelebrationHandler struct {
+	celebrationService *service.CelebrationService
+	birthdayService    *service.BirthdayService
+	userService        *service.UserService
+	config             *config.Config
+}
+
+func NewCelebrationHandler(celebrationService *service.CelebrationService, birthdayService *service.BirthdayService, userService *service.UserService, cfg *config.Config) *CelebrationHandler {
+	return &CelebrationHandler{
+		celebrationService: celebrationService,
+		birthdayService:    birthdayService,
+		userService:        userService,
+		config:             cfg,
+	}
+}
+
+func (h *CelebrationHandler) RegisterRoutes(r *gin.Engine) {
+	auth := middleware.JWTAuth(func() []byte {
+		return h.userService.GetJWTSecret()
+	})
+
+	api := r.Group("/api/v1")
+
+	// RSVP links are signed so that invitees can answer without an account.
+	api.GET("/rsvp/:token", h.GetInvitation)
+	api.PUT("/rsvp/:token", h.RespondToInvitation)
+
+	birthdays := api.Group("/birthdays")
+	birthdays.Use(auth)
+	{
+		birthdays.GET("/:id/celebrations", h.GetBirthdayCelebrations)
+		birthdays.POST("/:id/celebrations", h.CreateCelebration)
+	}
+
+	celebrations := api.Group("/celebrations")
+	celebrations.Use(auth)
+	{
+		celebrations.GET("", h.GetUserCelebrations)
+		celebrations.GET("/:id", h.GetCelebrationByID)
+		celebrations.PUT("/:id", h.UpdateCelebration)
+		celebrations.DELETE("/:id", h.DeleteCelebration)
+		celebrations.POST("/:id/tasks", h.AddCelebrationTask)
+		celebrations.PUT("/:id/tasks/:task", h.UpdateCelebrationTask)
+		celebrations.DELETE("/:id/tasks/:task", h.DeleteCelebrationTask)
+		celebrations.POST("/:id/guests", h.AddCelebrationGuest)
+		celebrations.PUT("/:id/guests/:guest", h.UpdateCelebrationGuest)
+		celebrations.DELETE("/:id/guests/:guest", h.DeleteCelebrationGuest)
+	}
+}
+
+// calendar returns the user's date settings, falling back to UTC and the
+// default leap-day policy when the user cannot be loaded.
+func (h *CelebrationHandler) calendar(userID uuid.UUID) models.Calendar {
+	user, err := h.userService.GetUserByID(userID)
+	if err != nil {
+		return models.DefaultCalendar(time.Now())
+	}
+	return user.Calendar(time.Now())
+}
+
+// ownedBirthday loads the birthday named by the :id path parameter and checks
+// that it belongs to the authenticated user. It writes the error response and
+// returns nil when the birthday cannot be used.
+func (h *CelebrationHandler) ownedBirthday(c *gin.Context) *models.Birthday {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		return nil
+	}
+
+	birthday, err := h.birthdayService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found"})
+		return nil
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if birthday.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return nil
+	}
+
+	return birthday
+}
+
+// ownedCelebration loads the celebration named by the :id path parameter and
+// its birthday, and checks that the birthday belongs to the authenticated
+// user. It writes the error response and returns nil when the celebration
+// cannot be used.
+func (h *CelebrationHandler) ownedCelebration(c *gin.Context) (*models.Birthday, *models.Celebration) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid celebration ID"})
+		return nil, nil
+	}
+
+	celebration, err := h.celebrationService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Celebration not found"})
+		return nil, nil
+	}
+
+	birthday, err := h.birthdayService.GetByID(celebration.BirthdayID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Celebration not found"})
+		return nil, nil
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if birthday.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return nil, nil
+	}
+
+	return birthday, celebration
+}
+
+// rsvpURL returns the public RSVP link of a guest
+func (h *CelebrationHandler) rsvpURL(c *gin.Context, guestID uuid.UUID) string {
+	return baseURL(c, h.config) + "/api/v1/rsvp/" + h.celebrationService.RSVPToken(guestID)
+}
+
+// celebrationResponse returns celebration with the RSVP links of its guests
+func (h *CelebrationHandler) celebrationResponse(c *gin.Context, celebration *models.Celebration, birthday *models.Birthday) *models.CelebrationResponse {
+	response := celebration.ToResponse(birthday)
+	h.addRSVPURLs(c, response)
+	return response
+}
+
+func (h *CelebrationHandler) addRSVPURLs(c *gin.Context, response *models.CelebrationResponse) {
+	for _, guest := range response.Guests {
+		guest.RSVPURL = h.rsvpURL(c, guest.ID)
+	}
+}
+
+// reload writes the celebration named by id, as changed by a task or guest
+// operation, with the given status
+func (h *CelebrationHandler) reload(c *gin.Context, status int, id uuid.UUID, birthday *models.Birthday) {
+	celebration, err := h.celebrationService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch celebration"})
+		return
+	}
+
+	c.JSON(status, h.celebrationResponse(c, celebration, birthday))
+}
+
+// celebrationError writes the response for an error returned when saving a
+// celebration, task or guest
+func celebrationError(c *gin.Context, err error, message string) {
+	if errors.Is(err, service.ErrInvalidCelebration) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+}
+
+// GetBirthdayCelebrations godoc
+// @Summary Get the celebrations of a birthday
+// @Description Get the celebrations planned for a birthday (must belong to authenticated user), by date, with their tasks, guests and RSVP links
+// @Tags celebrations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Success 200 {array} models.CelebrationResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/celebrations [get]
+func (h *CelebrationHandler) GetBirthdayCelebrations(c *gin.Context) {
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	celebrations, err := h.celebrationService.GetByBirthday(birthday)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch celebrations"})
+		return
+	}
+	for _, celebration := range celebrations {
+		h.addRSVPURLs(c, celebration)
+	}
+
+	c.JSON(http.StatusOK, celebrations)
+}
+
+// CreateCelebration godoc
+// @Summary Plan a celebration of a birthday
+// @Description Plan a celebration of one occurrence (year) of a birthday (must belong to authenticated user). The date defaults to the birthday in that year.
+// @Tags celebrations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param celebration body models.CreateCelebrationRequest true "Celebration details"
+// @Success 201 {object} models.CelebrationResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/celebrations [post]
+func (h *CelebrationHandler) CreateCelebration(c *gin.Context) {
+	var req models.CreateCelebrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	celebration, err := h.celebrationService.Create(birthday, h.calendar(birthday.UserID), &req)
+	if err != nil {
+		celebrationError(c, err, "Failed to create celebration")
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.celebrationResponse(c, celebration, birthday))
+}
+
+// GetUserCelebrations godoc
+// @Summary Get user's celebrations
+// @Description Get the celebrations of all of the authenticated user's birthdays, by date
+// @Tags celebrations
+// @Produce json
+// @Security Bearer
+// @Param upcoming query bool false "Only celebrations from today on"
+// @Success 200 {array} models.CelebrationResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /celebrations [get]
+func (h *CelebrationHandler) GetUserCelebrations(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var from *time.Time
+	if c.Query("upcoming") == "true" {
+		today := h.calendar(userID).Today
+		from = &today
+	}
+
+	celebrations, err := h.celebrationService.GetByUserID(userID, from)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch celebrations"})
+		return
+	}
+	for _, celebration := range celebrations {
+		h.addRSVPURLs(c, celebration)
+	}
+
+	c.JSON(http.StatusOK, celebrations)
+}
+
+// GetCelebrationByID godoc
+// @Summary Get a celebration by ID
+// @Description Get a celebration with its tasks, guests and RSVP links (its birthday must belong to authenticated user)
+// @Tags celebrations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Celebration ID"
+// @Success 200 {object} models.CelebrationResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /celebrations/{id} [get]
+func (h *CelebrationHandler) GetCelebrationByID(c *gin.Context) {
+	birthday, celebration := h.ownedCelebration(c)
+	if celebration == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, h.celebrationResponse(c, celebration, birthday))
+}
+
+// UpdateCelebration godoc
+// @Summary Update a celebration
+// @Description Replace the year, date, location and notes of a celebration (its birthday must belong to authenticated user)
+// @Tags celebrations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Celebration ID"
+// @Param celebration body models.CreateCelebrationRequest true "Celebration details"
+// @Success 200 {object} models.CelebrationResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /celebrations/{id} [put]
+func (h *CelebrationHandler) UpdateCelebration(c *gin.Context) {
+	var req models.CreateCelebrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	birthday, celebration := h.ownedCelebration(c)
+	if celebration == nil {
+		return
+	}
+
+	if err := h.celebrationService.Update(celebration, birthday, h.calendar(birthday.UserID), &req); err != nil {
+		celebrationError(c, err, "Failed to update celebration")
+		return
+	}
+
+	c.JSON(http.StatusOK, h.celebrationResponse(c, celebration, birthday))
+}
+
+// DeleteCelebration godoc
+// @Summary Delete a celebration
+// @Description Delete a celebration with its tasks and guests (its birthday must belong to authenticated user). The guests' RSVP links stop working.
+// @Tags celebrations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Celebration ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /celebrations/{id} [delete]
+func (h *CelebrationHandler) DeleteCelebration(c *gin.Context) {
+	_, celebration := h.ownedCelebration(c)
+	if celebration == nil {
+		return
+	}
+
+	if err := h.celebrationService.Delete(celebration.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete celebration"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Celebration deleted successfully"})
+}
+
+// AddCelebrationTask godoc
+// @Summary Add a task to a celebration
+// @Description Add a task to the checklist of a celebration (its birthday must belong to authenticated user) and return the updated celebration
+// @Tags celebrations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Celebration ID"
+// @Param task body models.CreateCelebrationTaskRequest true "Task details"
+// @Success 201 {object} models.CelebrationResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /celebrations/{id}/tasks [post]
+func (h *CelebrationHandler) AddCelebrationTask(c *gin.Context) {
+	var req models.CreateCelebrationTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	birthday, celebration := h.ownedCelebration(c)
+	if celebration == nil {
+		return
+	}
+
+	if _, err := h.celebrationService.AddTask(celebration, &req); err != nil {
+		celebrationError(c, err, "Failed to add task")
+		return
+	}
+
+	h.reload(c, http.StatusCreated, celebration.ID, birthday)
+}
+
+// UpdateCelebrationTask godoc
+// @Summary Update a celebration task
+// @Description Rename a task or check it off (its birthday must belong to authenticated user) and return the updated celebration
+// @Tags celebrations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Celebration ID"
+// @Param task path string true "Task ID"
+// @Param body body models.CreateCelebrationTaskRequest true "Task details"
+// @Success 200 {object} models.CelebrationResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Celebration or task not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /celebrations/{id}/tasks/{task} [put]
+func (h *CelebrationHandler) UpdateCelebrationTask(c *gin.Context) {
+	var req models.CreateCelebrationTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	taskID, err := uuid.Parse(c.Param("task"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	birthday, celebration := h.ownedCelebration(c)
+	if celebration == nil {
+		return
+	}
+
+	task, err := h.celebrationService.GetTask(celebration, taskID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.celebrationService.UpdateTask(task, &req); err != nil {
+		celebrationError(c, err, "Failed to update task")
+		return
+	}
+
+	h.reload(c, http.StatusOK, celebration.ID, birthday)
+}
+
+// DeleteCelebrationTask godoc
+// @Summary Delete a celebration task
+// @Description Remove a task from the checklist of a celebration (its birthday must belong to authenticated user) and return the updated celebration
+// @Tags celebrations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Celebration ID"
+// @Param task path string true "Task ID"
+// @Success 200 {object} models.CelebrationResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Celebration or task not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /celebrations/{id}/tasks/{task} [delete]
+func (h *CelebrationHandler) DeleteCelebrationTask(c *gin.Context) {
+	taskID, err := uuid.Parse(c.Param("task"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	birthday, celebration := h.ownedCelebration(c)
+	if celebration == nil {
+		return
+	}
+
+	task, err := h.celebrationService.GetTask(celebration, taskID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.celebrationService.DeleteTask(task.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete task"})
+		return
+	}
+
+	h.reload(c, http.StatusOK, celebration.ID, birthday)
+}
+
+// AddCelebrationGuest godoc
+// @Summary Invite a guest to a celebration
+// @Description Add a guest to the guest list of a celebration (its birthday must belong to authenticated user) and return the updated celebration, which includes the guest's public RSVP link
+// @Tags celebrations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Celebration ID"
+// @Param guest body models.CreateCelebrationGuestRequest true "Guest details"
+// @Success 201 {object} models.CelebrationResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /celebrations/{id}/guests [post]
+func (h *CelebrationHandler) AddCelebrationGuest(c *gin.Context) {
+	var req models.CreateCelebrationGuestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	birthday, celebration := h.ownedCelebration(c)
+	if celebration == nil {
+		return
+	}
+
+	if _, err := h.celebrationService.AddGuest(celebration, &req); err != nil {
+		celebrationError(c, err, "Failed to add guest")
+		return
+	}
+
+	h.reload(c, http.StatusCreated, celebration.ID, birthday)
+}
+
+// UpdateCelebrationGuest godoc
+// @Summary Update a celebration guest
+// @Description Replace a guest's name and contact, and optionally record their RSVP status (its birthday must belong to authenticated user). Returns the updated celebration.
+// @Tags celebrations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Celebration ID"
+// @Param guest path string true "Guest ID"
+// @Param body body models.CreateCelebrationGuestRequest true "Guest details"
+// @Success 200 {object} models.CelebrationResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Celebration or guest not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /celebrations/{id}/guests/{guest} [put]
+func (h *CelebrationHandler) UpdateCelebrationGuest(c *gin.Context) {
+	var req models.CreateCelebrationGuestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	guestID, err := uuid.Parse(c.Param("guest"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid guest ID"})
+		return
+	}
+
+	birthday, celebration := h.ownedCelebration(c)
+	if celebration == nil {
+		return
+	}
+
+	guest, err := h.celebrationService.GetGuest(celebration, guestID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.celebrationService.UpdateGuest(guest, &req); err != nil {
+		celebrationError(c, err, "Failed to update guest")
+		return
+	}
+
+	h.reload(c, http.StatusOK, celebration.ID, birthday)
+}
+
+// DeleteCelebrationGuest godoc
+// @Summary Remove a celebration guest
+// @Description Remove a guest from the guest list of a celebration (its birthday must belong to authenticated user), revoking their RSVP link. Returns the updated celebration.
+// @Tags celebrations
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Celebration ID"
+// @Param guest path string true "Guest ID"
+// @Success 200 {object} models.CelebrationResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Celebration or guest not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /celebrations/{id}/guests/{guest} [delete]
+func (h *CelebrationHandler) DeleteCelebrationGuest(c *gin.Context) {
+	guestID, err := uuid.Parse(c.Param("guest"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid guest ID"})
+		return
+	}
+
+	birthday, celebration := h.ownedCelebration(c)
+	if celebration == nil {
+		return
+	}
+
+	guest, err := h.celebrationService.GetGuest(celebration, guestID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.celebrationService.DeleteGuest(guest.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete guest"})
+		return
+	}
+
+	h.reload(c, http.StatusOK, celebration.ID, birthday)
+}
+
+// rsvpError writes the response for an error returned for an RSVP link
+func rsvpError(c *gin.Context, err error) {
+	switch {
+	case err == service.ErrInvalidRSVPLink:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case err == service.ErrGuestNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Invitation not found"})
+	case errors.Is(err, service.ErrInvalidCelebration):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process RSVP"})
+	}
+}
+
+// GetInvitation godoc
+// @Summary Get an invitation
+// @Description Get the celebration a guest is invited to and their current answer. Authenticated by the signed token of the guest's RSVP link instead of a JWT.
+// @Tags rsvp
+// @Produce json
+// @Param token path string true "RSVP token"
+// @Success 200 {object} models.RSVPResponse
+// @Failure 403 {object} map[string]string "Invalid link"
+// @Failure 404 {object} map[string]string "Invitation not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /rsvp/{token} [get]
+func (h *CelebrationHandler) GetInvitation(c *gin.Context) {
+	invitation, err := h.celebrationService.GetInvitation(c.Param("token"))
+	if err != nil {
+		rsvpError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.JSON(http.StatusOK, invitation)
+}
+
+// RespondToInvitation godoc
+// @Summary Answer an invitation
+// @Description Accept or decline an invitation, or answer maybe, optionally with a message for the host. Guests can change their answer until they are removed from the guest list.
+// @Description Authenticated by the signed token of the guest's RSVP link instead of a JWT.
+// @Tags rsvp
+// @Accept json
+// @Produce json
+// @Param token path string true "RSVP token"
+// @Param rsvp body models.RSVPRequest true "Answer"
+// @Success 200 {object} models.RSVPResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 403 {object} map[string]string "Invalid link"
+// @Failure 404 {object} map[string]string "Invitation not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /rsvp/{token} [put]
+func (h *CelebrationHandler) RespondToInvitation(c *gin.Context) {
+	var req models.RSVPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	invitation, err := h.celebrationService.Respond(c.Param("token"), &req)
+	if err != nil {
+		rsvpError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.JSON(http.StatusOK, invitation)
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/config"
+	"github.com/murathanje/birthday_tracking_backend/internal/middleware"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/service"
+)
+
+type ExportHandler struct {
+	exportService *service.ExportService
+	userService   *service.UserService
+	config        *config.Config
+}
+
+func NewExportHandler(exportService *service.ExportService, userService *service.UserService, cfg *config.Config) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+		userService:   userService,
+		config:        cfg,
+	}
+}
+
+func (h *ExportHandler) RegisterRoutes(r *gin.Engine) {
+	api := r.Group("/api/v1")
+
+	// Download links are signed so that they can be opened in a browser,
+	// which cannot send the Authorization header.
+	api.GET("/exports/:id/download", h.DownloadExport)
+
+	exports := api.Group("/users/me/export")
+	exports.Use(middleware.JWTAuth(func() []byte {
+		return h.userService.GetJWTSecret()
+	}))
+	{
+		exports.POST("", h.RequestExport)
+		exports.GET("", h.GetUserExports)
+		exports.GET("/:id", h.GetExportByID)
+	}
+}
+
+// exportResponse returns export with a fresh download link while it is ready
+func (h *ExportHandler) exportResponse(c *gin.Context, export *models.DataExport) *models.DataExportResponse {
+	response := export.ToResponse()
+	if export.Status == models.ExportStatusReady {
+		query, expires := h.exportService.DownloadLink(export, time.Now())
+		response.DownloadURL = baseURL(c, h.config) + "/api/v1/exports/" + export.ID.String() + "/download?" + query
+		response.DownloadExpiresAt = &expires
+	}
+	return response
+}
+
+// RequestExport godoc
+// @Summary Request a data export
+// @Description Start building a ZIP archive with all of the authenticated user's data: the profile, birthdays with their revision history and relationships, categories, tags, households, gift ideas, budgets, journal entries, celebrations and calendar feed settings as JSON, plus the birthdays as CSV and iCalendar and their photos under photos/.
+// @Description The archive is built in the background; poll the export until its status is ready and use download_url to fetch it.
+// @Description Archives are deleted when they expire.
+// @Tags users
+// @Produce json
+// @Security Bearer
+// @Success 202 {object} models.DataExportResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 409 {object} map[string]string "An export is already being built"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /users/me/export [post]
+func (h *ExportHandler) RequestExport(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	export, err := h.exportService.RequestExport(userID)
+	if err == service.ErrExportInProgress {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to request export"})
+		return
+	}
+
+	c.Header("Location", "/api/v1/users/me/export/"+export.ID.String())
+	c.JSON(http.StatusAccepted, h.exportResponse(c, export))
+}
+
+// GetUserExports godoc
+// @Summary Get user's data exports
+// @Description Get all data exports of the authenticated user, newest first
+// @Tags users
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} models.DataExportResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /users/me/export [get]
+func (h *ExportHandler) GetUserExports(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	exports, err := h.exportService.GetByUserID(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch exports"})
+		return
+	}
+
+	response := make([]*models.DataExportResponse, len(exports))
+	for i := range exports {
+		response[i] = h.exportResponse(c, &exports[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetExportByID godoc
+// @Summary Get a data export
+// @Description Get the status of a data export (must belong to authenticated user). Ready exports include a download link valid for one hour.
+// @Tags users
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Export ID"
+// @Success 200 {object} models.DataExportResponse
+// @Failure 400 {object} map[string]string "Invalid export ID"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /users/me/export/{id} [get]
+func (h *ExportHandler) GetExportByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid export ID"})
+		return
+	}
+
+	export, err := h.exportService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Export not found"})
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if export.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return
+	}
+
+	c.JSON(http.StatusOK, h.exportResponse(c, export))
+}
+
+// DownloadExport godoc
+// @Summary Download a data export
+// @Description Download the ZIP archive of a ready export. Authenticated by the signed, time-limited link returned as download_url instead of a JWT.
+// @Tags users
+// @Produce application/zip
+// @Param id path string true "Export ID"
+// @Param expires query int true "Link expiry (Unix time)"
+// @Param signature query string true "Link signature"
+// @Success 200 {file} file "ZIP archive"
+// @Failure 400 {object} map[string]string "Invalid export ID"
+// @Failure 403 {object} map[string]string "Invalid or expired link"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 410 {object} map[string]string "Archive expired"
+// @Router /exports/{id}/download [get]
+func (h *ExportHandler) DownloadExport(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid export ID"})
+		return
+	}
+
+	export, err := h.exportService.VerifyDownload(id, c.Query("expires"), c.Query("signature"), time.Now())
+	switch err {
+	case nil:
+	case service.ErrInvalidDownloadLink:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	case service.ErrExportExpired:
+		c.JSON(http.StatusGone, gin.H{"error": err.Error()})
+		return
+	default:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Export not found"})
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.FileAttachment(export.FilePath, service.ArchiveName(export))
+}
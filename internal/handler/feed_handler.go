@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/murathanje/birthday_tracking_backend/internal/config"
+	"github.com/murathanje/birthday_tracking_backend/internal/middleware"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/service"
+)
+
+type FeedHandler struct {
+	feedService *service.FeedService
+	userService *service.UserService
+	config      *config.Config
+}
+
+func NewFeedHandler(feedService *service.FeedService, userService *service.UserService, cfg *config.Config) *FeedHandler {
+	return &FeedHandler{
+		feedService: feedService,
+		userService: userService,
+		config:      cfg,
+	}
+}
+
+func (h *FeedHandler) RegisterRoutes(r *gin.Engine) {
+	api := r.Group("/api/v1")
+
+	// Calendar apps cannot send an Authorization header, so the feed itself
+	// is authenticated by the secret token in its URL.
+	api.GET("/feeds/:token/birthdays.ics", h.GetBirthdayFeed)
+
+	feeds := api.Group("/feeds")
+	feeds.Use(middleware.JWTAuth(func() []byte {
+		return h.userService.GetJWTSecret()
+	}))
+	{
+		feeds.POST("", h.CreateFeed)
+		feeds.GET("", h.GetFeed)
+		feeds.PUT("", h.UpdateFeed)
+		feeds.POST("/rotate", h.RotateFeedToken)
+		feeds.DELETE("", h.RevokeFeed)
+	}
+}
+
+// baseURL returns the scheme and host of the API for links that are opened
+// outside the API client. The configured public base URL is used when set;
+// otherwise it is taken from the request itself. Forwarding headers such as
+// X-Forwarded-Proto are ignored because any client can send them.
+func baseURL(c *gin.Context, cfg *config.Config) string {
+	if cfg.PublicBaseURL != "" {
+		return cfg.PublicBaseURL
+	}
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host
+}
+
+// feedResponse returns the feed together with its token and subscription URL
+func (h *FeedHandler) feedResponse(c *gin.Context, feed *models.CalendarFeed, token string) *models.CalendarFeedResponse {
+	response := feed.ToResponse()
+	response.Token = token
+	response.URL = baseURL(c, h.config) + "/api/v1/feeds/" + token + "/birthdays.ics"
+	return response
+}
+
+// CreateFeed godoc
+// @Summary Create the calendar feed
+// @Description Create a secret iCalendar subscription URL for the authenticated user's birthdays.
+// @Description The token is only returned once; rotate it to get a new URL.
+// @Tags feeds
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param feed body models.CalendarFeedRequest false "Feed settings"
+// @Success 201 {object} models.CalendarFeedResponse
+// @Failure 400 {object} map[string]string "Invalid request body"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 409 {object} map[string]string "Feed already exists"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /feeds [post]
+func (h *FeedHandler) CreateFeed(c *gin.Context) {
+	var req models.CalendarFeedRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	feed, token, err := h.feedService.CreateFeed(userID, &req)
+	if err == service.ErrFeedExists {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create feed"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.feedResponse(c, feed, token))
+}
+
+// GetFeed godoc
+// @Summary Get the calendar feed
+// @Description Get the settings of the authenticated user's calendar feed (without the token)
+// @Tags feeds
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} models.CalendarFeedResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 404 {object} map[string]string "No feed"
+// @Router /feeds [get]
+func (h *FeedHandler) GetFeed(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	feed, err := h.feedService.GetFeed(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, feed.ToResponse())
+}
+
+// UpdateFeed godoc
+// @Summary Update the calendar feed
+// @Description Update the reminder settings of the authenticated user's calendar feed. The URL does not change.
+// @Tags feeds
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param feed body models.CalendarFeedRequest true "Feed settings"
+// @Success 200 {object} models.CalendarFeedResponse
+// @Failure 400 {object} map[string]string "Invalid request body"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 404 {object} map[string]string "No feed"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /feeds [put]
+func (h *FeedHandler) UpdateFeed(c *gin.Context) {
+	var req models.CalendarFeedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	feed, err := h.feedService.UpdateFeed(userID, &req)
+	if err == service.ErrFeedNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update feed"})
+		return
+	}
+
+	c.JSON(http.StatusOK, feed.ToResponse())
+}
+
+// RotateFeedToken godoc
+// @Summary Rotate the calendar feed token
+// @Description Replace the feed's secret token. The previous subscription URL stops working immediately.
+// @Tags feeds
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} models.CalendarFeedResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 404 {object} map[string]string "No feed"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /feeds/rotate [post]
+func (h *FeedHandler) RotateFeedToken(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	feed, token, err := h.feedService.RotateToken(userID)
+	if err == service.ErrFeedNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to rotate feed token"})
+		return
+	}
+
+	c.JSON(http.StatusOK, h.feedResponse(c, feed, token))
+}
+
+// RevokeFeed godoc
+// @Summary Revoke the calendar feed
+// @Description Delete the authenticated user's calendar feed. Its URL stops working immediately.
+// @Tags feeds
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 404 {object} map[string]string "No feed"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /feeds [delete]
+func (h *FeedHandler) RevokeFeed(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	err = h.feedService.RevokeFeed(userID)
+	if err == service.ErrFeedNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke feed"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Feed revoked successfully"})
+}
+
+// GetBirthdayFeed godoc
+// @Summary Get the birthday calendar feed
+// @Description iCalendar feed of all of a user's birthdays as yearly recurring all-day events, for subscribing from calendar apps.
+// @Description Authenticated by the secret token in the URL instead of a JWT.
+// @Tags feeds
+// @Produce text/calendar
+// @Param token path string true "Feed token"
+// @Success 200 {file} file "iCalendar feed"
+// @Failure 404 {object} map[string]string "Unknown or revoked token"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /feeds/{token}/birthdays.ics [get]
+func (h *FeedHandler) GetBirthdayFeed(c *gin.Context) {
+	feed, err := h.feedService.GetByToken(c.Param("token"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Feed not found"})
+		return
+	}
+
+	calendar, err := h.feedService.Calendar(feed)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to render feed"})
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := calendar.Encode(&buf); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to render feed"})
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-cache")
+	c.Header("Content-Disposition", `inline; filename="birthdays.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", buf.Bytes())
+}
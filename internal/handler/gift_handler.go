@@ -0,0 +1,326 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/middleware"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/service"
+)
+
+type GiftHandler struct {
+	giftService     *service.GiftService
+	birthdayService *service.BirthdayService
+	userService     *service.UserService
+}
+
+func NewGiftHandler(giftService *service.GiftService, birthdayService *service.BirthdayService, userService *service.UserService) *GiftHandler {
+	return &GiftHandler{
+		giftService:     giftService,
+		birthdayService: birthdayService,
+		userService:     userService,
+	}
+}
+
+func (h *GiftHandler) RegisterRoutes(r *gin.Engine) {
+	auth := middleware.JWTAuth(func() []byte {
+		return h.userService.GetJWTSecret()
+	})
+
+	api := r.Group("/api/v1")
+	birthdays := api.Group("/birthdays")
+	birthdays.Use(auth)
+	{
+		birthdays.GET("/:id/gifts", h.GetBirthdayGifts)
+		birthdays.POST("/:id/gifts", h.CreateGift)
+		birthdays.GET("/:id/gifts/:gift", h.GetGiftByID)
+		birthdays.PUT("/:id/gifts/:gift", h.UpdateGift)
+		birthdays.DELETE("/:id/gifts/:gift", h.DeleteGift)
+	}
+
+	gifts := api.Group("/gifts")
+	gifts.Use(auth)
+	{
+		gifts.GET("/upcoming", h.GetUpcomingGifts)
+	}
+}
+
+// calendar returns the user's date settings, falling back to UTC and the
+// default leap-day policy when the user cannot be loaded.
+func (h *GiftHandler) calendar(userID uuid.UUID) models.Calendar {
+	user, err := h.userService.GetUserByID(userID)
+	if err != nil {
+		return models.DefaultCalendar(time.Now())
+	}
+	return user.Calendar(time.Now())
+}
+
+// ownedBirthday loads the birthday named by the :id path parameter and checks
+// that it belongs to the authenticated user. It writes the error response and
+// returns nil when the birthday cannot be used.
+func (h *GiftHandler) ownedBirthday(c *gin.Context) *models.Birthday {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		return nil
+	}
+
+	birthday, err := h.birthdayService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found"})
+		return nil
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if birthday.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return nil
+	}
+
+	return birthday
+}
+
+// ownedGift loads the birthday named by :id and its gift named by :gift. It
+// writes the error response and returns nil when either cannot be used.
+func (h *GiftHandler) ownedGift(c *gin.Context) (*models.Birthday, *models.Gift) {
+	giftID, err := uuid.Parse(c.Param("gift"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid gift ID"})
+		return nil, nil
+	}
+
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return nil, nil
+	}
+
+	gift, err := h.giftService.GetByID(giftID)
+	if err != nil || gift.BirthdayID != birthday.ID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Gift not found"})
+		return nil, nil
+	}
+
+	return birthday, gift
+}
+
+// respond writes gift together with the year a gift with the same title was
+// last given to the same person
+func (h *GiftHandler) respond(c *gin.Context, status int, gift *models.Gift) {
+	previous, err := h.giftService.PreviouslyGivenYear(gift)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch gifts"})
+		return
+	}
+
+	c.JSON(status, gift.ToResponse(previous))
+}
+
+// GetBirthdayGifts godoc
+// @Summary Get the gifts for a birthday
+// @Description Get the gift ideas for a birthday (must belong to authenticated user). Gifts not given yet come first, by priority and then oldest first,
+// @Description followed by the gifts already given, most recently given first. Gifts not given yet include previously_given_year when a gift with the same title was given before.
+// @Tags gifts
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param status query string false "Only gifts not given yet (open) or with the given status" Enums(open,idea,purchased,wrapped,given)
+// @Success 200 {array} models.GiftResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/gifts [get]
+func (h *GiftHandler) GetBirthdayGifts(c *gin.Context) {
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	gifts, err := h.giftService.GetByBirthday(birthday.ID, c.Query("status"))
+	if errors.Is(err, service.ErrInvalidGift) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch gifts"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gifts)
+}
+
+// CreateGift godoc
+// @Summary Add a gift idea
+// @Description Add a gift idea for a birthday (must belong to authenticated user). New gifts start as ideas unless another status is given.
+// @Tags gifts
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param gift body models.CreateGiftRequest true "Gift details"
+// @Success 201 {object} models.GiftResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/gifts [post]
+func (h *GiftHandler) CreateGift(c *gin.Context) {
+	var req models.CreateGiftRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	gift, err := h.giftService.Create(birthday, h.calendar(birthday.UserID), &req)
+	if errors.Is(err, service.ErrInvalidGift) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create gift"})
+		return
+	}
+
+	h.respond(c, http.StatusCreated, gift)
+}
+
+// GetGiftByID godoc
+// @Summary Get a gift by ID
+// @Description Get a gift for a birthday (must belong to authenticated user)
+// @Tags gifts
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param gift path string true "Gift ID"
+// @Success 200 {object} models.GiftResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Birthday or gift not found"
+// @Router /birthdays/{id}/gifts/{gift} [get]
+func (h *GiftHandler) GetGiftByID(c *gin.Context) {
+	_, gift := h.ownedGift(c)
+	if gift == nil {
+		return
+	}
+
+	h.respond(c, http.StatusOK, gift)
+}
+
+// UpdateGift godoc
+// @Summary Update a gift
+// @Description Replace the details of a gift (must belong to authenticated user). An omitted status keeps the current one;
+// @Description statuses can only move forward through idea, purchased, wrapped and given, but may skip steps. Given gifts default given_year to the current year.
+// @Tags gifts
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param gift path string true "Gift ID"
+// @Param details body models.CreateGiftRequest true "Gift details"
+// @Success 200 {object} models.GiftResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Birthday or gift not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/gifts/{gift} [put]
+func (h *GiftHandler) UpdateGift(c *gin.Context) {
+	var req models.CreateGiftRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	birthday, gift := h.ownedGift(c)
+	if gift == nil {
+		return
+	}
+
+	err := h.giftService.Update(gift, h.calendar(birthday.UserID), &req)
+	if errors.Is(err, service.ErrInvalidGift) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update gift"})
+		return
+	}
+
+	h.respond(c, http.StatusOK, gift)
+}
+
+// DeleteGift godoc
+// @Summary Delete a gift
+// @Description Delete a gift for a birthday (must belong to authenticated user)
+// @Tags gifts
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param gift path string true "Gift ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Birthday or gift not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/gifts/{gift} [delete]
+func (h *GiftHandler) DeleteGift(c *gin.Context) {
+	_, gift := h.ownedGift(c)
+	if gift == nil {
+		return
+	}
+
+	if err := h.giftService.Delete(gift.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete gift"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Gift deleted successfully"})
+}
+
+// GetUpcomingGifts godoc
+// @Summary Get open gift ideas for upcoming birthdays
+// @Description Get the birthdays of the authenticated user observed within the next N days that have gifts not given yet, ordered by next occurrence, each with those gifts.
+// @Tags gifts
+// @Produce json
+// @Security Bearer
+// @Param days query int false "Window size in days, including today (1-366)" default(30)
+// @Success 200 {array} models.UpcomingGiftsResponse
+// @Failure 400 {object} map[string]string "Invalid days parameter"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /gifts/upcoming [get]
+func (h *GiftHandler) GetUpcomingGifts(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil || days < 1 || days > service.MaxUpcomingDays {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid days parameter"})
+		return
+	}
+
+	upcoming, err := h.giftService.GetUpcoming(userID, h.calendar(userID), days)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch upcoming gifts"})
+		return
+	}
+
+	c.JSON(http.StatusOK, upcoming)
+}
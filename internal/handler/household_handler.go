@@ -0,0 +1,360 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/middleware"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/service"
+)
+
+type HouseholdHandler struct {
+	householdService *service.HouseholdService
+	userService      *service.UserService
+}
+
+func NewHouseholdHandler(householdService *service.HouseholdService, userService *service.UserService) *HouseholdHandler {
+	return &HouseholdHandler{
+		householdService: householdService,
+		userService:      userService,
+	}
+}
+
+func (h *HouseholdHandler) RegisterRoutes(r *gin.Engine) {
+	api := r.Group("/api/v1")
+	households := api.Group("/households")
+	households.Use(middleware.JWTAuth(func() []byte {
+		return h.userService.GetJWTSecret()
+	}))
+	{
+		households.POST("", h.CreateHousehold)
+		households.GET("", h.GetUserHouseholds)
+		households.GET("/:id", h.GetHouseholdByID)
+		households.PUT("/:id", h.UpdateHousehold)
+		households.DELETE("/:id", h.DeleteHousehold)
+		households.GET("/:id/members", h.GetHouseholdMembers)
+		households.PUT("/:id/members/:birthday_id", h.AddHouseholdMember)
+		households.DELETE("/:id/members/:birthday_id", h.RemoveHouseholdMember)
+	}
+}
+
+// ownedHousehold loads the household named by the :id path parameter and
+// checks that it belongs to the authenticated user. It writes the error
+// response and returns nil when the household cannot be used.
+func (h *HouseholdHandler) ownedHousehold(c *gin.Context) *models.Household {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid household ID"})
+		return nil
+	}
+
+	household, err := h.householdService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Household not found"})
+		return nil
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if household.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return nil
+	}
+
+	return household
+}
+
+// respond writes household with its member count
+func (h *HouseholdHandler) respond(c *gin.Context, status int, household *models.Household) {
+	count, err := h.householdService.CountMembers(household)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to count household members"})
+		return
+	}
+
+	c.JSON(status, household.ToResponse(count))
+}
+
+// CreateHousehold godoc
+// @Summary Create a household
+// @Description Create a household for the authenticated user. Households group birthdays of people who live together and can have their own address.
+// @Tags households
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param household body models.CreateHouseholdRequest true "Household details"
+// @Success 201 {object} models.HouseholdResponse
+// @Failure 400 {object} map[string]string "Invalid request body"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /households [post]
+func (h *HouseholdHandler) CreateHousehold(c *gin.Context) {
+	var req models.CreateHouseholdRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	household, err := h.householdService.Create(userID, &req)
+	if errors.Is(err, service.ErrInvalidHousehold) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create household"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, household.ToResponse(0))
+}
+
+// GetUserHouseholds godoc
+// @Summary Get user's households
+// @Description Get all households of the authenticated user with their member counts, ordered by name
+// @Tags households
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} models.HouseholdResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /households [get]
+func (h *HouseholdHandler) GetUserHouseholds(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	households, err := h.householdService.GetByUserID(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch households"})
+		return
+	}
+
+	c.JSON(http.StatusOK, households)
+}
+
+// GetHouseholdByID godoc
+// @Summary Get a household by ID
+// @Description Get a household by its ID (must belong to authenticated user)
+// @Tags households
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Household ID"
+// @Success 200 {object} models.HouseholdResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /households/{id} [get]
+func (h *HouseholdHandler) GetHouseholdByID(c *gin.Context) {
+	household := h.ownedHousehold(c)
+	if household == nil {
+		return
+	}
+
+	h.respond(c, http.StatusOK, household)
+}
+
+// UpdateHousehold godoc
+// @Summary Update a household
+// @Description Replace the name and address of a household (must belong to authenticated user)
+// @Tags households
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Household ID"
+// @Param household body models.CreateHouseholdRequest true "Household details"
+// @Success 200 {object} models.HouseholdResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /households/{id} [put]
+func (h *HouseholdHandler) UpdateHousehold(c *gin.Context) {
+	var req models.CreateHouseholdRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	household := h.ownedHousehold(c)
+	if household == nil {
+		return
+	}
+
+	err := h.householdService.Update(household, &req)
+	if errors.Is(err, service.ErrInvalidHousehold) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update household"})
+		return
+	}
+
+	h.respond(c, http.StatusOK, household)
+}
+
+// DeleteHousehold godoc
+// @Summary Delete a household
+// @Description Delete a household (must belong to authenticated user). Its members are kept without a household.
+// @Tags households
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Household ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /households/{id} [delete]
+func (h *HouseholdHandler) DeleteHousehold(c *gin.Context) {
+	household := h.ownedHousehold(c)
+	if household == nil {
+		return
+	}
+
+	if err := h.householdService.Delete(household.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete household"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Household deleted successfully"})
+}
+
+// GetHouseholdMembers godoc
+// @Summary Get household members
+// @Description Get the birthdays in a household (must belong to authenticated user), ordered by name. Birthdays in the trash are left out.
+// @Tags households
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Household ID"
+// @Success 200 {array} models.BirthdayResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /households/{id}/members [get]
+func (h *HouseholdHandler) GetHouseholdMembers(c *gin.Context) {
+	household := h.ownedHousehold(c)
+	if household == nil {
+		return
+	}
+
+	members, err := h.householdService.GetMembers(household.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch household members"})
+		return
+	}
+
+	cal := h.calendar(household.UserID)
+	response := make([]*models.BirthdayResponse, len(members))
+	for i := range members {
+		response[i] = members[i].ToResponse(cal)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// AddHouseholdMember godoc
+// @Summary Add a household member
+// @Description Move a birthday into a household (both must belong to authenticated user). A birthday belongs to at most one household and leaves its previous one.
+// @Tags households
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Household ID"
+// @Param birthday_id path string true "Birthday ID"
+// @Success 200 {object} models.HouseholdResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Household or birthday not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /households/{id}/members/{birthday_id} [put]
+func (h *HouseholdHandler) AddHouseholdMember(c *gin.Context) {
+	birthdayID, err := uuid.Parse(c.Param("birthday_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		return
+	}
+
+	household := h.ownedHousehold(c)
+	if household == nil {
+		return
+	}
+
+	err = h.householdService.AddMember(household, birthdayID)
+	if err == service.ErrBirthdayNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add household member"})
+		return
+	}
+
+	h.respond(c, http.StatusOK, household)
+}
+
+// RemoveHouseholdMember godoc
+// @Summary Remove a household member
+// @Description Take a birthday out of a household (must belong to authenticated user). The birthday itself is kept.
+// @Tags households
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Household ID"
+// @Param birthday_id path string true "Birthday ID"
+// @Success 200 {object} models.HouseholdResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Household or member not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /households/{id}/members/{birthday_id} [delete]
+func (h *HouseholdHandler) RemoveHouseholdMember(c *gin.Context) {
+	birthdayID, err := uuid.Parse(c.Param("birthday_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		return
+	}
+
+	household := h.ownedHousehold(c)
+	if household == nil {
+		return
+	}
+
+	err = h.householdService.RemoveMember(household, birthdayID)
+	if err == service.ErrBirthdayNotFound || err == service.ErrNotAMember {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove household member"})
+		return
+	}
+
+	h.respond(c, http.StatusOK, household)
+}
+
+// calendar returns the user's date settings, falling back to UTC and the
+// default leap-day policy when the user cannot be loaded.
+func (h *HouseholdHandler) calendar(userID uuid.UUID) models.Calendar {
+	user, err := h.userService.GetUserByID(userID)
+	if err != nil {
+		return models.DefaultCalendar(time.Now())
+	}
+	return user.Calendar(time.Now())
+}
@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/middleware"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/service"
+)
+
+type InteractionHandler struct {
+	interactionService *service.InteractionService
+	birthdayService    *service.BirthdayService
+	userService        *service.UserService
+}
+
+func NewInteractionHandler(interactionService *service.InteractionService, birthdayService *service.BirthdayService, userService *service.UserService) *InteractionHandler {
+	return &InteractionHandler{
+		interactionService: interactionService,
+		birthdayService:    birthdayService,
+		userService:        userService,
+	}
+}
+
+func (h *InteractionHandler) RegisterRoutes(r *gin.Engine) {
+	api := r.Group("/api/v1")
+	birthdays := api.Group("/birthdays")
+	birthdays.Use(middleware.JWTAuth(func() []byte {
+		return h.userService.GetJWTSecret()
+	}))
+	{
+		birthdays.GET("/:id/interactions", h.GetBirthdayInteractions)
+		birthdays.POST("/:id/interactions", h.CreateInteraction)
+		birthdays.GET("/:id/interactions/:interaction", h.GetInteractionByID)
+		birthdays.PUT("/:id/interactions/:interaction", h.UpdateInteraction)
+		birthdays.DELETE("/:id/interactions/:interaction", h.DeleteInteraction)
+		birthdays.GET("/:id/timeline", h.GetBirthdayTimeline)
+	}
+}
+
+// ownedBirthday loads the birthday named by the :id path parameter and checks
+// that it belongs to the authenticated user. It writes the error response and
+// returns nil when the birthday cannot be used.
+func (h *InteractionHandler) ownedBirthday(c *gin.Context) *models.Birthday {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid birthday ID"})
+		return nil
+	}
+
+	birthday, err := h.birthdayService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Birthday not found"})
+		return nil
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if birthday.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return nil
+	}
+
+	return birthday
+}
+
+// ownedInteraction loads the birthday named by :id and its journal entry
+// named by :interaction. It writes the error response and returns nil when
+// either cannot be used.
+func (h *InteractionHandler) ownedInteraction(c *gin.Context) *models.Interaction {
+	interactionID, err := uuid.Parse(c.Param("interaction"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid interaction ID"})
+		return nil
+	}
+
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return nil
+	}
+
+	interaction, err := h.interactionService.GetByID(interactionID)
+	if err != nil || interaction.BirthdayID != birthday.ID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Interaction not found"})
+		return nil
+	}
+
+	return interaction
+}
+
+// GetBirthdayInteractions godoc
+// @Summary Get the interaction journal of a birthday
+// @Description Get the journal entries for a birthday (must belong to authenticated user), oldest first
+// @Tags interactions
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Success 200 {array} models.InteractionResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/interactions [get]
+func (h *InteractionHandler) GetBirthdayInteractions(c *gin.Context) {
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	interactions, err := h.interactionService.GetByBirthday(birthday.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch interactions"})
+		return
+	}
+
+	c.JSON(http.StatusOK, interactions)
+}
+
+// CreateInteraction godoc
+// @Summary Add an interaction to the journal of a birthday
+// @Description Record a call, meeting, card, given gift or other interaction with the person of a birthday (must belong to authenticated user), optionally linked to one of their gifts
+// @Tags interactions
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param interaction body models.CreateInteractionRequest true "Interaction details"
+// @Success 201 {object} models.InteractionResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/interactions [post]
+func (h *InteractionHandler) CreateInteraction(c *gin.Context) {
+	var req models.CreateInteractionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	interaction, err := h.interactionService.Create(birthday, &req)
+	if errors.Is(err, service.ErrInvalidInteraction) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create interaction"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, interaction.ToResponse())
+}
+
+// GetInteractionByID godoc
+// @Summary Get a journal entry by ID
+// @Description Get a journal entry for a birthday (must belong to authenticated user)
+// @Tags interactions
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param interaction path string true "Interaction ID"
+// @Success 200 {object} models.InteractionResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Birthday or interaction not found"
+// @Router /birthdays/{id}/interactions/{interaction} [get]
+func (h *InteractionHandler) GetInteractionByID(c *gin.Context) {
+	interaction := h.ownedInteraction(c)
+	if interaction == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, interaction.ToResponse())
+}
+
+// UpdateInteraction godoc
+// @Summary Update a journal entry
+// @Description Replace the details of a journal entry (must belong to authenticated user). An omitted occurred_at keeps the current one.
+// @Tags interactions
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param interaction path string true "Interaction ID"
+// @Param body body models.CreateInteractionRequest true "Interaction details"
+// @Success 200 {object} models.InteractionResponse
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Birthday or interaction not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/interactions/{interaction} [put]
+func (h *InteractionHandler) UpdateInteraction(c *gin.Context) {
+	var req models.CreateInteractionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	interaction := h.ownedInteraction(c)
+	if interaction == nil {
+		return
+	}
+
+	err := h.interactionService.Update(interaction, &req)
+	if errors.Is(err, service.ErrInvalidInteraction) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update interaction"})
+		return
+	}
+
+	c.JSON(http.StatusOK, interaction.ToResponse())
+}
+
+// DeleteInteraction godoc
+// @Summary Delete a journal entry
+// @Description Delete a journal entry for a birthday (must belong to authenticated user)
+// @Tags interactions
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Param interaction path string true "Interaction ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Birthday or interaction not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/interactions/{interaction} [delete]
+func (h *InteractionHandler) DeleteInteraction(c *gin.Context) {
+	interaction := h.ownedInteraction(c)
+	if interaction == nil {
+		return
+	}
+
+	if err := h.interactionService.Delete(interaction.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete interaction"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Interaction deleted successfully"})
+}
+
+// GetBirthdayTimeline godoc
+// @Summary Get the timeline of a birthday
+// @Description Get the journal entries of a birthday (must belong to authenticated user) merged with its system events (created, updated, deleted, restored, reverted, merged), in chronological order.
+// @Description Journal entries have source journal and the interaction type; system events have source system, the revision action, the acting user and the changed fields.
+// @Tags interactions
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Birthday ID"
+// @Success 200 {array} models.TimelineEntry
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /birthdays/{id}/timeline [get]
+func (h *InteractionHandler) GetBirthdayTimeline(c *gin.Context) {
+	birthday := h.ownedBirthday(c)
+	if birthday == nil {
+		return
+	}
+
+	timeline, err := h.interactionService.GetTimeline(birthday.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch timeline"})
+		return
+	}
+
+	c.JSON(http.StatusOK, timeline)
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/middleware"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/service"
+)
+
+type TagHandler struct {
+	tagService  *service.TagService
+	userService *service.UserService
+}
+
+func NewTagHandler(tagService *service.TagService, userService *service.UserService) *TagHandler {
+	return &TagHandler{
+		tagService:  tagService,
+		userService: userService,
+	}
+}
+
+func (h *TagHandler) RegisterRoutes(r *gin.Engine) {
+	api := r.Group("/api/v1")
+	tags := api.Group("/tags")
+	tags.Use(middleware.JWTAuth(func() []byte {
+		return h.userService.GetJWTSecret()
+	}))
+	{
+		tags.GET("", h.GetUserTags)
+		tags.DELETE("/:id", h.DeleteTag)
+	}
+}
+
+// GetUserTags godoc
+// @Summary Get user's tags
+// @Description Get all tags of the authenticated user with the number of birthdays carrying each
+// @Tags tags
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} models.TagResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /tags [get]
+func (h *TagHandler) GetUserTags(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	tags, err := h.tagService.GetByUserID(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch tags"})
+		return
+	}
+	if tags == nil {
+		tags = []models.TagResponse{}
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+// DeleteTag godoc
+// @Summary Delete a tag
+// @Description Delete a tag and detach it from every birthday (must belong to authenticated user)
+// @Tags tags
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Tag ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not found"
+// @Router /tags/{id} [delete]
+func (h *TagHandler) DeleteTag(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tag ID"})
+		return
+	}
+
+	tag, err := h.tagService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if tag.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return
+	}
+
+	if err := h.tagService.Delete(id); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete tag"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Tag deleted successfully"})
+}
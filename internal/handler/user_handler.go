@@ -108,6 +108,10 @@ func (h *UserHandler) Register(c *gin.Context) {
 	}
 
 	user, err := h.service.CreateUser(&req)
+	if err == service.ErrInvalidTimezone || err == service.ErrInvalidLeapDay {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user: " + err.Error()})
 		return
@@ -0,0 +1,98 @@
+package ical
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/murathanje/birthday_tracking_backend/internal/contentline"
+)
+
+// ErrInvalid is returned for files that are not iCalendar files
+var ErrInvalid = errors.New("not an iCalendar file")
+
+// Decode reads the VEVENTs of an iCalendar file in order. Only the
+// properties of Event are read, and only the date of DTSTART is kept; Date
+// is zero when DTSTART is missing or malformed. Nested components such as
+// VALARM are ignored.
+func Decode(r io.Reader) ([]Event, error) {
+	properties, err := contentline.Read(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	var events []Event
+	var event *Event
+	calendar := false
+	depth := 0
+	for _, prop := range properties {
+		component := strings.ToUpper(prop.Value)
+		switch {
+		case prop.Name == "BEGIN" && component == "VCALENDAR":
+			calendar = true
+		case prop.Name == "BEGIN" && component == "VEVENT" && event == nil:
+			event = &Event{}
+			depth = 0
+		case event == nil:
+			continue
+		case prop.Name == "BEGIN":
+			depth++
+		case prop.Name == "END" && depth > 0:
+			depth--
+		case prop.Name == "END" && component == "VEVENT":
+			events = append(events, *event)
+			event = nil
+		case depth > 0:
+			continue
+		case prop.Name == "UID":
+			event.UID = strings.TrimSpace(prop.Value)
+		case prop.Name == "SUMMARY":
+			event.Summary = strings.TrimSpace(contentline.Unescape(prop.Value))
+		case prop.Name == "DESCRIPTION":
+			event.Description = strings.TrimSpace(contentline.Unescape(prop.Value))
+		case prop.Name == "DTSTART":
+			event.Date = parseDate(prop.Value)
+		case prop.Name == "RRULE":
+			event.RRule = strings.ToUpper(strings.TrimSpace(prop.Value))
+		case prop.Name == "CATEGORIES":
+			for _, category := range contentline.Split(prop.Value, ',') {
+				if category = strings.TrimSpace(category); category != "" {
+					event.Categories = append(event.Categories, category)
+				}
+			}
+		}
+	}
+
+	if !calendar {
+		return nil, ErrInvalid
+	}
+	return events, nil
+}
+
+// RRuleParts splits a recurrence rule such as FREQ=YEARLY;BYMONTH=2 into its
+// upper-cased parts.
+func RRuleParts(rrule string) map[string]string {
+	parts := make(map[string]string)
+	for _, part := range strings.Split(rrule, ";") {
+		key, value, _ := strings.Cut(part, "=")
+		if key != "" {
+			parts[strings.ToUpper(strings.TrimSpace(key))] = strings.ToUpper(strings.TrimSpace(value))
+		}
+	}
+	return parts
+}
+
+// parseDate reads the date of a DATE or DATE-TIME value, ignoring the time
+func parseDate(value string) time.Time {
+	value = strings.TrimSpace(value)
+	if len(value) < len(dateFormat) {
+		return time.Time{}
+	}
+	date, err := time.Parse(dateFormat, value[:len(dateFormat)])
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}
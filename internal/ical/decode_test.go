@@ -0,0 +1,131 @@
+package ical
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestDecode(t *testing.T) {
+	input := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"UID:jane@example.com",
+		"DTSTART;VALUE=DATE:19900415",
+		"RRULE:freq=yearly",
+		"SUMMARY:Jane’s birthday",
+		`DESCRIPTION:Likes tea\, not coffee\nand cake`,
+		`CATEGORIES:Friends,Book club\, Tuesday`,
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"DESCRIPTION:Reminder",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:couple@example.com",
+		"DTSTART;TZID=Europe/Berlin:20150620T143000",
+		"RRULE:FREQ=YEARLY",
+		"SUMMARY:John & Jane's wedding anniversary",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:broken@example.com",
+		"DTSTART:June 1st",
+		"SUMMARY:Broken",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	events, err := Decode(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	want := []Event{
+		{
+			UID:         "jane@example.com",
+			Date:        date(1990, time.April, 15),
+			RRule:       "FREQ=YEARLY",
+			Summary:     "Jane’s birthday",
+			Description: "Likes tea, not coffee\nand cake",
+			Categories:  []string{"Friends", "Book club, Tuesday"},
+		},
+		{
+			UID:     "couple@example.com",
+			Date:    date(2015, time.June, 20),
+			RRule:   "FREQ=YEARLY",
+			Summary: "John & Jane's wedding anniversary",
+		},
+		{UID: "broken@example.com", Summary: "Broken"},
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Decode() = %+v, want %+v", events, want)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	for _, input := range []string{"", "BEGIN:VCARD\nFN:Jane\nEND:VCARD\n", "BEGIN:VEVENT\nUID:x\nEND:VEVENT\n"} {
+		if _, err := Decode(strings.NewReader(input)); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Decode(%q) error = %v, want ErrInvalid", input, err)
+		}
+	}
+}
+
+func TestDecodeEncoded(t *testing.T) {
+	calendar := &Calendar{
+		ProdID: "-//Test//EN",
+		Events: []Event{{
+			UID:         "550e8400-e29b-41d4-a716-446655440000@birthday-tracking",
+			Stamp:       time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC),
+			Date:        date(1990, time.February, 28),
+			Summary:     "Jane Doe-Longname-With-A-Very-Long-Family-Name-That-Needs-Folding's birthday",
+			Description: "Notes; with, escaped\ncharacters",
+			Categories:  []string{"Family"},
+			RRule:       "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=-1",
+			Alarm:       &Alarm{Before: 24 * time.Hour, Description: "Reminder"},
+		}},
+	}
+
+	var buf bytes.Buffer
+	if err := calendar.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	events, err := Decode(&buf)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	want := calendar.Events[0]
+	want.Stamp = time.Time{}
+	want.Alarm = nil
+	if len(events) != 1 || !reflect.DeepEqual(events[0], want) {
+		t.Errorf("Decode() = %+v, want [%+v]", events, want)
+	}
+}
+
+func TestRRuleParts(t *testing.T) {
+	tests := []struct {
+		rrule string
+		want  map[string]string
+	}{
+		{rrule: "FREQ=YEARLY", want: map[string]string{"FREQ": "YEARLY"}},
+		{rrule: "freq=yearly; bymonth=2 ;BYMONTHDAY=-1", want: map[string]string{"FREQ": "YEARLY", "BYMONTH": "2", "BYMONTHDAY": "-1"}},
+		{rrule: "", want: map[string]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rrule, func(t *testing.T) {
+			if got := RRuleParts(tt.rrule); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RRuleParts(%q) = %v, want %v", tt.rrule, got, tt.want)
+			}
+		})
+	}
+}
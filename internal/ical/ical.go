@@ -0,0 +1,182 @@
+// Package ical reads and writes iCalendar (RFC 5545) data.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405Z"
+	maxLineOctets  = 75
+)
+
+// Calendar is a VCALENDAR object
+type Calendar struct {
+	ProdID string
+	Name   string
+	// RefreshInterval tells subscribing clients how often to poll the calendar
+	RefreshInterval time.Duration
+	Events          []Event
+}
+
+// Event is an all-day VEVENT
+type Event struct {
+	UID         string
+	Stamp       time.Time
+	Date        time.Time
+	Summary     string
+	Description string
+	Categories  []string
+	// RRule is the recurrence rule without the "RRULE:" prefix
+	RRule string
+	Alarm *Alarm
+}
+
+// Alarm is a display VALARM. Before is the time before the start of the event
+// at which it fires; negative values fire after the start.
+type Alarm struct {
+	Before      time.Duration
+	Description string
+}
+
+// Encode writes c to w with CRLF line endings and folded long lines
+func (c *Calendar) Encode(w io.Writer) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", c.ProdID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		e.line("X-WR-CALNAME", EscapeText(c.Name))
+	}
+	if c.RefreshInterval > 0 {
+		e.line("REFRESH-INTERVAL;VALUE=DURATION", FormatDuration(c.RefreshInterval))
+		e.line("X-PUBLISHED-TTL", FormatDuration(c.RefreshInterval))
+	}
+
+	for i := range c.Events {
+		event := &c.Events[i]
+		e.line("BEGIN", "VEVENT")
+		e.line("UID", event.UID)
+		e.line("DTSTAMP", event.Stamp.UTC().Format(dateTimeFormat))
+		e.line("DTSTART;VALUE=DATE", event.Date.Format(dateFormat))
+		e.line("DTEND;VALUE=DATE", event.Date.AddDate(0, 0, 1).Format(dateFormat))
+		if event.RRule != "" {
+			e.line("RRULE", event.RRule)
+		}
+		e.line("SUMMARY", EscapeText(event.Summary))
+		if event.Description != "" {
+			e.line("DESCRIPTION", EscapeText(event.Description))
+		}
+		if len(event.Categories) > 0 {
+			categories := make([]string, len(event.Categories))
+			for i, category := range event.Categories {
+				categories[i] = EscapeText(category)
+			}
+			e.line("CATEGORIES", strings.Join(categories, ","))
+		}
+		e.line("TRANSP", "TRANSPARENT")
+		if event.Alarm != nil {
+			e.line("BEGIN", "VALARM")
+			e.line("ACTION", "DISPLAY")
+			e.line("DESCRIPTION", EscapeText(event.Alarm.Description))
+			e.line("TRIGGER", FormatDuration(-event.Alarm.Before))
+			e.line("END", "VALARM")
+		}
+		e.line("END", "VEVENT")
+	}
+
+	e.line("END", "VCALENDAR")
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// EscapeText escapes a TEXT property value
+func EscapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+// FormatDuration formats d as an RFC 5545 DURATION such as -P1DT9H
+func FormatDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+	seconds := d / time.Second
+
+	var b strings.Builder
+	b.WriteString(sign + "P")
+	if days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+	}
+	if hours > 0 || minutes > 0 || seconds > 0 {
+		b.WriteString("T")
+		if hours > 0 {
+			fmt.Fprintf(&b, "%dH", hours)
+		}
+		if minutes > 0 {
+			fmt.Fprintf(&b, "%dM", minutes)
+		}
+		if seconds > 0 {
+			fmt.Fprintf(&b, "%dS", seconds)
+		}
+	}
+	if days == 0 && hours == 0 && minutes == 0 && seconds == 0 {
+		b.WriteString("T0S")
+	}
+	return b.String()
+}
+
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+// line writes a content line, folding it after 75 octets without splitting
+// UTF-8 sequences.
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+
+	content := name + ":" + value
+	limit := maxLineOctets
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		if _, e.err = e.w.WriteString(content[:cut] + "\r\n "); e.err != nil {
+			return
+		}
+		content = content[cut:]
+		// Continuation lines start with a space, which counts towards the limit.
+		limit = maxLineOctets - 1
+	}
+	_, e.err = e.w.WriteString(content + "\r\n")
+}
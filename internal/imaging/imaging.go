@@ -0,0 +1,157 @@
+// Package imaging decodes uploaded photos and re-encodes them at a bounded
+// size. Only the pixels are written back, so metadata such as EXIF (camera,
+// location) is dropped.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format, expected JPEG, PNG or GIF")
+	ErrTooLarge          = errors.New("image dimensions are too large")
+	ErrInvalid           = errors.New("invalid image")
+)
+
+// MaxPixels limits the decoded size of an image, to protect against small
+// files that decompress to huge bitmaps.
+const MaxPixels = 40_000_000
+
+// Content types of the formats accepted by Decode
+var contentTypes = map[string]string{
+	"image/jpeg": "jpeg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+// Encoded is an encoded image
+type Encoded struct {
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// Decode sniffs the format of data from its content, decodes it and applies
+// the EXIF orientation of JPEG photos. GIFs are reduced to their first frame.
+// It returns the decoded image and its format: "jpeg", "png" or "gif".
+func Decode(data []byte) (image.Image, string, error) {
+	format, ok := contentTypes[http.DetectContentType(data)]
+	if !ok {
+		return nil, "", ErrUnsupportedFormat
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrInvalid
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, "", ErrInvalid
+	}
+	if config.Width*config.Height > MaxPixels {
+		return nil, "", ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrInvalid
+	}
+	if format == "jpeg" {
+		img = orient(img, jpegOrientation(data))
+	}
+	return img, format, nil
+}
+
+// Fit scales img down so that neither side exceeds size. Smaller images are
+// returned unchanged.
+func Fit(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= size && h <= size {
+		return img
+	}
+	if w >= h {
+		h = max(1, h*size/w)
+		w = size
+	} else {
+		w = max(1, w*size/h)
+		h = size
+	}
+	return resize(img, b, w, h)
+}
+
+// Thumbnail crops the largest centered square out of img and scales it to
+// size by size.
+func Thumbnail(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x := b.Min.X + (b.Dx()-side)/2
+	y := b.Min.Y + (b.Dy()-side)/2
+	return resize(img, image.Rect(x, y, x+side, y+side), min(size, side), min(size, side))
+}
+
+// Encode encodes img as a JPEG, or as a PNG when the original format was PNG
+// or GIF so that transparency is kept.
+func Encode(img image.Image, format string) (*Encoded, error) {
+	var buf bytes.Buffer
+	contentType := "image/png"
+	var err error
+	if format == "jpeg" {
+		contentType = "image/jpeg"
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90})
+	} else {
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b := img.Bounds()
+	return &Encoded{
+		Data:        buf.Bytes(),
+		ContentType: contentType,
+		Width:       b.Dx(),
+		Height:      b.Dy(),
+	}, nil
+}
+
+// resize scales the area r of img to w by h pixels. Every destination pixel
+// is the average of the source pixels it covers, which gives good results
+// when shrinking.
+func resize(img image.Image, r image.Rectangle, w, h int) image.Image {
+	src := image.NewRGBA(image.Rect(0, 0, r.Dx(), r.Dy()))
+	draw.Draw(src, src.Bounds(), img, r.Min, draw.Src)
+	sw, sh := r.Dx(), r.Dy()
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		y0, y1 := y*sh/h, max((y+1)*sh/h, y*sh/h+1)
+		for x := 0; x < w; x++ {
+			x0, x1 := x*sw/w, max((x+1)*sw/w, x*sw/w+1)
+
+			var sum [4]int
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride+x0*4 : sy*src.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					sum[0] += int(row[i])
+					sum[1] += int(row[i+1])
+					sum[2] += int(row[i+2])
+					sum[3] += int(row[i+3])
+				}
+			}
+			n := (y1 - y0) * (x1 - x0)
+			i := dst.PixOffset(x, y)
+			for c := 0; c < 4; c++ {
+				dst.Pix[i+c] = uint8((sum[c] + n/2) / n)
+			}
+		}
+	}
+	return dst
+}
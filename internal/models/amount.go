@@ -0,0 +1,69 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxAmount is the largest amount accepted, in hundredths
+const MaxAmount Amount = 1e12 - 1
+
+// Amount is a non-negative sum of money in hundredths of its currency unit,
+// so 49.99 EUR is stored as 4999. In JSON it is written as a decimal string
+// ("49.99") and read from either a string or a number with at most two
+// decimal places.
+type Amount int64
+
+// ParseAmount parses a decimal amount such as "49.99", "50" or "0.5"
+func ParseAmount(value string) (Amount, error) {
+	value = strings.TrimSpace(value)
+	whole, fraction, hasFraction := strings.Cut(value, ".")
+	if whole == "" || len(fraction) > 2 || (hasFraction && fraction == "") || !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("invalid amount %q, expected a non-negative number with at most two decimal places", value)
+	}
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+
+	units, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil || Amount(units) > MaxAmount {
+		return 0, fmt.Errorf("amount %q is too large", value)
+	}
+	return Amount(units), nil
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// String formats the amount with two decimal places
+func (a Amount) String() string {
+	return fmt.Sprintf("%d.%02d", a/100, a%100)
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	value := string(data)
+	if bytes.HasPrefix(data, []byte(`"`)) {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+	}
+	amount, err := ParseAmount(value)
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // CreateBirthdayRequest represents the request for creating a birthday
@@ -13,29 +14,101 @@ type CreateBirthdayRequest struct {
 	// @Description Name of the person
 	Name string `json:"name" binding:"required" example:"John Doe"`
 
-	// @Description Birthday date (format: MM-DD)
-	BirthDate string `json:"birth_date" binding:"required" example:"05-15"`
+	// @Description Birthday date (format: YYYY-MM-DD, or MM-DD when the birth year is unknown); for other event types the date of the first occurrence, e.g. the wedding date
+	BirthDate string `json:"birth_date" binding:"required" example:"1990-05-15"`
 
-	// @Description Category of the birthday (e.g., "Family", "Friend", "Work")
-	Category string `json:"category" binding:"required" example:"Family"`
+	// @Description Kind of event; defaults to birthday on create and is kept when omitted on updates
+	EventType string `json:"event_type,omitempty" enums:"birthday,wedding_anniversary,anniversary,work_anniversary,memorial,name_day,other" example:"birthday"`
+
+	// @Description Second person of a wedding anniversary or anniversary
+	PartnerName string `json:"partner_name,omitempty" example:"Jane Doe"`
+
+	// @Description Category name (e.g., "Family", "Friend", "Work"); matched case-insensitively and created if missing
+	Category string `json:"category" binding:"required,max=50" example:"Family"`
 
 	// @Description Optional notes about the birthday
 	Notes string `json:"notes,omitempty" example:"Best friend from college"`
+
+	// @Description Optional tags; unknown tags are created
+	Tags []string `json:"tags,omitempty" example:"college,vip"`
+
+	// @Description Optional phone numbers (up to 20)
+	Phones []PhoneNumber `json:"phones,omitempty"`
+
+	// @Description Optional email addresses (up to 20)
+	Emails []EmailAddress `json:"emails,omitempty"`
+
+	// @Description Optional postal addresses (up to 20)
+	Addresses []PostalAddress `json:"addresses,omitempty"`
+
+	// @Description Optional social network handles (up to 20)
+	SocialHandles []SocialHandle `json:"social_handles,omitempty"`
+}
+
+// PatchBirthdayRequest documents the JSON merge patch accepted when partially
+// updating a birthday. Every member is optional.
+// @Description Merge patch for a birthday record; omitted fields are left unchanged
+type PatchBirthdayRequest struct {
+	// @Description Name of the person
+	Name *string `json:"name,omitempty" example:"John Doe"`
+
+	// @Description Birthday date (format: YYYY-MM-DD or MM-DD)
+	BirthDate *string `json:"birth_date,omitempty" example:"1990-05-15"`
+
+	// @Description Kind of event
+	EventType *string `json:"event_type,omitempty" enums:"birthday,wedding_anniversary,anniversary,work_anniversary,memorial,name_day,other" example:"wedding_anniversary"`
+
+	// @Description Second person of an anniversary; null clears it
+	PartnerName *string `json:"partner_name,omitempty" example:"Jane Doe"`
+
+	// @Description Category name; unknown categories are created
+	Category *string `json:"category,omitempty" example:"Friend"`
+
+	// @Description Notes; null clears them
+	Notes *string `json:"notes,omitempty" example:"Moved to Berlin"`
+
+	// @Description Full replacement tag list; null clears all tags
+	Tags []string `json:"tags,omitempty" example:"college"`
+
+	// @Description Full replacement list of phone numbers; null clears them
+	Phones []PhoneNumber `json:"phones,omitempty"`
+
+	// @Description Full replacement list of email addresses; null clears them
+	Emails []EmailAddress `json:"emails,omitempty"`
+
+	// @Description Full replacement list of postal addresses; null clears them
+	Addresses []PostalAddress `json:"addresses,omitempty"`
+
+	// @Description Full replacement list of social network handles; null clears them
+	SocialHandles []SocialHandle `json:"social_handles,omitempty"`
 }
 
-// Birthday represents a birthday record
+// Birthday represents a birthday record, or another yearly recurring event
+// of the type named by EventType. SearchText holds Contact.SearchText() for
+// the q filter of the birthday list.
 // @Description Birthday model for tracking birthdays
 type Birthday struct {
-	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	UserID     uuid.UUID `gorm:"type:uuid;not null" json:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
-	User       User      `gorm:"foreignKey:UserID" json:"-"`
-	Name       string    `gorm:"size:100;not null" json:"name" example:"John Doe"`
-	BirthMonth int       `gorm:"not null" json:"birth_month" example:"5"`
-	BirthDay   int       `gorm:"not null" json:"birth_day" example:"15"`
-	Category   string    `gorm:"size:50;not null" json:"category" example:"Family"`
-	Notes      string    `gorm:"type:text" json:"notes" example:"Best friend from college"`
-	CreatedAt  time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
-	UpdatedAt  time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+	ID          uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID      uuid.UUID      `gorm:"type:uuid;not null" json:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	User        User           `gorm:"foreignKey:UserID" json:"-"`
+	Name        string         `gorm:"size:100;not null" json:"name" example:"John Doe"`
+	EventType   string         `gorm:"size:30;not null;default:'birthday';index" json:"event_type" example:"birthday"`
+	PartnerName string         `gorm:"size:100;not null;default:''" json:"partner_name,omitempty" example:"Jane Doe"`
+	BirthMonth  int            `gorm:"not null" json:"birth_month" example:"5"`
+	BirthDay    int            `gorm:"not null" json:"birth_day" example:"15"`
+	BirthYear   *int           `json:"birth_year,omitempty" example:"1990"`
+	CategoryID  *uuid.UUID     `gorm:"type:uuid;index" json:"category_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	Category    string         `gorm:"size:50;not null" json:"category" example:"Family"`
+	Notes       string         `gorm:"type:text" json:"notes" example:"Best friend from college"`
+	Contact     ContactDetails `gorm:"type:jsonb;not null;default:'{}'" json:"-"`
+	SearchText  string         `gorm:"type:text;not null;default:''" json:"-"`
+	HouseholdID *uuid.UUID     `gorm:"type:uuid;index" json:"household_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440012"`
+	Tags        []Tag          `gorm:"many2many:birthday_tags;constraint:OnDelete:CASCADE" json:"-"`
+	Photo       *BirthdayPhoto `gorm:"foreignKey:BirthdayID;constraint:OnDelete:SET NULL" json:"-"`
+	SourceUID   *string        `gorm:"size:255;index" json:"-"`
+	CreatedAt   time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt   time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 // BirthdayResponse represents the response for birthday operations
@@ -50,8 +123,35 @@ type BirthdayResponse struct {
 	// @Description Name of the person
 	Name string `json:"name" example:"John Doe"`
 
-	// @Description Birthday date (format: MM-DD)
-	BirthDate string `json:"birth_date" example:"05-15"`
+	// @Description Kind of event
+	EventType string `json:"event_type" enums:"birthday,wedding_anniversary,anniversary,work_anniversary,memorial,name_day,other" example:"birthday"`
+
+	// @Description Second person of an anniversary
+	PartnerName string `json:"partner_name,omitempty" example:"Jane Doe"`
+
+	// @Description Icon of the event type
+	Icon string `json:"icon" example:"🎂"`
+
+	// @Description Wording of years and turning_years for the event type, e.g. "age" or "years married"; omitted when years are not counted
+	CountLabel string `json:"count_label,omitempty" example:"age"`
+
+	// @Description Birthday date (format: YYYY-MM-DD, or MM-DD when the birth year is unknown); for other event types the date of the first occurrence
+	BirthDate string `json:"birth_date" example:"1990-05-15"`
+
+	// @Description Current age in years (only present for birthdays when the birth year is known)
+	Age *int `json:"age,omitempty" example:"34"`
+
+	// @Description Age the person turns on the next occurrence (only present for birthdays when the birth year is known)
+	TurningAge *int `json:"turning_age,omitempty" example:"35"`
+
+	// @Description Completed years, worded by count_label (only present when the year is known and the event type counts years)
+	Years *int `json:"years,omitempty" example:"34"`
+
+	// @Description Years completed on the next occurrence, worded by count_label (only present when the year is known and the event type counts years)
+	TurningYears *int `json:"turning_years,omitempty" example:"35"`
+
+	// @Description ID of the birthday's category
+	CategoryID *uuid.UUID `json:"category_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
 
 	// @Description Category of the birthday
 	Category string `json:"category" example:"Family"`
@@ -59,6 +159,27 @@ type BirthdayResponse struct {
 	// @Description Optional notes about the birthday
 	Notes string `json:"notes,omitempty" example:"Best friend from college"`
 
+	// @Description Tags attached to the birthday, sorted by name
+	Tags []string `json:"tags" example:"college,vip"`
+
+	// @Description ID of the household the person belongs to
+	HouseholdID *uuid.UUID `json:"household_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440012"`
+
+	// @Description Phone numbers
+	Phones []PhoneNumber `json:"phones"`
+
+	// @Description Email addresses
+	Emails []EmailAddress `json:"emails"`
+
+	// @Description Postal addresses
+	Addresses []PostalAddress `json:"addresses"`
+
+	// @Description Social network handles
+	SocialHandles []SocialHandle `json:"social_handles"`
+
+	// @Description Photo of the person, if one was uploaded
+	Photo *BirthdayPhotoResponse `json:"photo,omitempty"`
+
 	// @Description When the record was created
 	CreatedAt time.Time `json:"created_at"`
 
@@ -66,16 +187,317 @@ type BirthdayResponse struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
-// ToResponse converts Birthday model to BirthdayResponse
-func (b *Birthday) ToResponse() *BirthdayResponse {
-	return &BirthdayResponse{
-		ID:        b.ID,
-		UserID:    b.UserID,
-		Name:      b.Name,
-		BirthDate: fmt.Sprintf("%02d-%02d", b.BirthMonth, b.BirthDay),
-		Category:  b.Category,
-		Notes:     b.Notes,
-		CreatedAt: b.CreatedAt,
-		UpdatedAt: b.UpdatedAt,
+// ToResponse converts Birthday model to BirthdayResponse. cal supplies the
+// user's local date and leap-day policy used to compute the ages.
+func (b *Birthday) ToResponse(cal Calendar) *BirthdayResponse {
+	eventType := b.Type()
+	response := &BirthdayResponse{
+		ID:          b.ID,
+		UserID:      b.UserID,
+		Name:        b.Name,
+		EventType:   eventType.Key,
+		PartnerName: b.PartnerName,
+		Icon:        eventType.Icon,
+		CountLabel:  eventType.CountLabel,
+		BirthDate:   b.FormatBirthDate(),
+		CategoryID:  b.CategoryID,
+		Category:    b.Category,
+		Notes:       b.Notes,
+		Tags:        b.TagNames(),
+		CreatedAt:   b.CreatedAt,
+		UpdatedAt:   b.UpdatedAt,
+
+		HouseholdID:   b.HouseholdID,
+		Phones:        nonNil(b.Contact.Phones),
+		Emails:        nonNil(b.Contact.Emails),
+		Addresses:     nonNil(b.Contact.Addresses),
+		SocialHandles: nonNil(b.Contact.SocialHandles),
+	}
+
+	if b.Photo != nil {
+		response.Photo = b.Photo.ToResponse()
+	}
+
+	if b.BirthYear != nil && eventType.Counted() {
+		years := b.AgeOn(cal.Today, cal.LeapDayPolicy)
+		response.Years = &years
+		response.TurningYears = b.YearsIn(b.NextOccurrence(cal).Year())
+		if eventType.Key == EventTypeBirthday {
+			response.Age = response.Years
+			response.TurningAge = response.TurningYears
+		}
+	}
+
+	return response
+}
+
+// TrashedBirthdayResponse represents a deleted birthday waiting in the trash
+// @Description Response model for a birthday in the trash
+type TrashedBirthdayResponse struct {
+	BirthdayResponse
+
+	// @Description When the birthday was deleted
+	DeletedAt time.Time `json:"deleted_at"`
+
+	// @Description When the birthday will be permanently deleted unless it is restored
+	PurgeAt time.Time `json:"purge_at"`
+}
+
+// ToTrashedResponse converts a deleted Birthday model to TrashedBirthdayResponse.
+// Trashed birthdays are purged retention after they were deleted.
+func (b *Birthday) ToTrashedResponse(cal Calendar, retention time.Duration) *TrashedBirthdayResponse {
+	return &TrashedBirthdayResponse{
+		BirthdayResponse: *b.ToResponse(cal),
+		DeletedAt:        b.DeletedAt.Time,
+		PurgeAt:          b.DeletedAt.Time.Add(retention),
+	}
+}
+
+// ToRequest converts Birthday model back to the CreateBirthdayRequest that
+// would produce it
+func (b *Birthday) ToRequest() *CreateBirthdayRequest {
+	return &CreateBirthdayRequest{
+		Name:        b.Name,
+		BirthDate:   b.FormatBirthDate(),
+		EventType:   b.Type().Key,
+		PartnerName: b.PartnerName,
+		Category:    b.Category,
+		Notes:       b.Notes,
+		Tags:        b.TagNames(),
+
+		Phones:        nonNil(b.Contact.Phones),
+		Emails:        nonNil(b.Contact.Emails),
+		Addresses:     nonNil(b.Contact.Addresses),
+		SocialHandles: nonNil(b.Contact.SocialHandles),
+	}
+}
+
+// TagNames returns the names of the birthday's loaded tags
+func (b *Birthday) TagNames() []string {
+	names := make([]string, len(b.Tags))
+	for i, tag := range b.Tags {
+		names[i] = tag.Name
+	}
+	return names
+}
+
+// FormatBirthDate renders the birth date as YYYY-MM-DD, or MM-DD when the
+// birth year is unknown.
+func (b *Birthday) FormatBirthDate() string {
+	if b.BirthYear != nil {
+		return fmt.Sprintf("%04d-%02d-%02d", *b.BirthYear, b.BirthMonth, b.BirthDay)
+	}
+	return fmt.Sprintf("%02d-%02d", b.BirthMonth, b.BirthDay)
+}
+
+// UpcomingBirthdayResponse represents a birthday together with its next occurrence
+// @Description Response model for upcoming birthdays
+type UpcomingBirthdayResponse struct {
+	BirthdayResponse
+
+	// @Description Date of the next occurrence in the user's timezone (format: YYYY-MM-DD)
+	NextDate string `json:"next_date" example:"2025-05-15"`
+
+	// @Description Number of days from today until the next occurrence (0 means today)
+	DaysUntil int `json:"days_until" example:"12"`
+}
+
+// ObservedDate returns the date on which the birthday is observed in year.
+// Feb 29 birthdays follow policy in non-leap years; ok is false when the
+// policy skips the year.
+func (b *Birthday) ObservedDate(year int, policy LeapDayPolicy) (date time.Time, ok bool) {
+	if b.BirthMonth == 2 && b.BirthDay == 29 && !IsLeapYear(year) {
+		switch policy {
+		case LeapDayFeb28:
+			return time.Date(year, time.February, 28, 0, 0, 0, 0, time.UTC), true
+		case LeapDaySkip:
+			return time.Time{}, false
+		default:
+			return time.Date(year, time.March, 1, 0, 0, 0, 0, time.UTC), true
+		}
+	}
+	return time.Date(year, time.Month(b.BirthMonth), b.BirthDay, 0, 0, 0, 0, time.UTC), true
+}
+
+// NextOccurrence returns the first observed date on or after cal.Today.
+func (b *Birthday) NextOccurrence(cal Calendar) time.Time {
+	for year := cal.Today.Year(); ; year++ {
+		if date, ok := b.ObservedDate(year, cal.LeapDayPolicy); ok && !date.Before(cal.Today) {
+			return date
+		}
+	}
+}
+
+// AgeOn returns the age in completed years on date. Under the skip policy a
+// Feb 29 birthday still counts as passed from Mar 1 in non-leap years.
+// BirthYear must be set.
+func (b *Birthday) AgeOn(date time.Time, policy LeapDayPolicy) int {
+	age := date.Year() - *b.BirthYear
+	anniversary, ok := b.ObservedDate(date.Year(), policy)
+	if !ok {
+		anniversary, _ = b.ObservedDate(date.Year(), LeapDayMar1)
+	}
+	if date.Before(anniversary) {
+		age--
+	}
+	return age
+}
+
+// IsLeapYear reports whether year is a leap year in the Gregorian calendar
+func IsLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// ToUpcomingResponse converts Birthday model to UpcomingBirthdayResponse relative to cal.Today
+func (b *Birthday) ToUpcomingResponse(cal Calendar) *UpcomingBirthdayResponse {
+	next := b.NextOccurrence(cal)
+	return &UpcomingBirthdayResponse{
+		BirthdayResponse: *b.ToResponse(cal),
+		NextDate:         next.Format("2006-01-02"),
+		DaysUntil:        int(next.Sub(cal.Today).Hours() / 24),
 	}
-} 
\ No newline at end of file
+}
+
+// ObservanceResponse represents the effective observance date of a birthday in a given year
+// @Description Effective observance date of a birthday in a given year
+type ObservanceResponse struct {
+	// @Description Calendar year
+	Year int `json:"year" example:"2025"`
+
+	// @Description Date the birthday is observed (format: YYYY-MM-DD), omitted when skipped
+	Date string `json:"date,omitempty" example:"2025-03-01"`
+
+	// @Description Whether the birthday is observed in this year
+	Observed bool `json:"observed" example:"true"`
+
+	// @Description Age the person turns in this year (only present for birthdays when the birth year is known)
+	TurningAge *int `json:"turning_age,omitempty" example:"35"`
+
+	// @Description Years completed in this year, worded by the event type's count_label (only present when the year is known and the event type counts years)
+	TurningYears *int `json:"turning_years,omitempty" example:"35"`
+}
+
+// ListBirthdaysQuery represents the query parameters for listing birthdays
+type ListBirthdaysQuery struct {
+	Type     string   `form:"type"`
+	Category string   `form:"category"`
+	Month    int      `form:"month" binding:"omitempty,min=1,max=12"`
+	Name     string   `form:"name"`
+	Q        string   `form:"q" binding:"omitempty,max=100"`
+	Tags     []string `form:"tags"`
+	TagMode  string   `form:"tag_mode" binding:"omitempty,oneof=any all"`
+	Sort     string   `form:"sort" binding:"omitempty,oneof=next name -name created_at -created_at"`
+	Limit    int      `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor   string   `form:"cursor"`
+}
+
+// BirthdayListResponse represents a page of birthdays
+// @Description Paginated list of birthdays
+type BirthdayListResponse struct {
+	// @Description Birthdays in this page
+	Items []*BirthdayResponse `json:"items"`
+
+	// @Description Opaque cursor for the next page, null when there are no more results
+	NextCursor *string `json:"next_cursor" example:"eyJrIjo1MTUsIm4iOiJKb2huIERvZSIsImkiOiI1NTBlODQwMC1lMjliLTQxZDQtYTcxNi00NDY2NTU0NDAwMDAifQ"`
+}
+
+// Bulk operation kinds and result statuses
+const (
+	BulkOpCreate = "create"
+	BulkOpUpdate = "update"
+	BulkOpDelete = "delete"
+
+	BulkStatusCreated    = "created"
+	BulkStatusUpdated    = "updated"
+	BulkStatusDeleted    = "deleted"
+	BulkStatusFailed     = "failed"
+	BulkStatusRolledBack = "rolled_back"
+)
+
+// BulkBirthdayRequest represents a batch of birthday operations
+// @Description Request model for bulk birthday operations
+type BulkBirthdayRequest struct {
+	// @Description When true, any failing operation rolls back the whole batch; otherwise valid operations are kept
+	AllOrNothing bool `json:"all_or_nothing" example:"true"`
+
+	// @Description Operations to run, in order
+	Operations []BulkBirthdayOperation `json:"operations" binding:"required,min=1,max=500"`
+}
+
+// BulkBirthdayOperation represents a single operation in a bulk request
+// @Description One create, update or delete operation
+type BulkBirthdayOperation struct {
+	// @Description Operation kind
+	Op string `json:"op" enums:"create,update,delete" example:"create"`
+
+	// @Description Birthday ID (required for update and delete)
+	ID *uuid.UUID `json:"id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// @Description Birthday details (required for create and update)
+	Birthday *CreateBirthdayRequest `json:"birthday,omitempty"`
+}
+
+// BulkBirthdayResult represents the outcome of a single bulk operation
+// @Description Outcome of one bulk operation
+type BulkBirthdayResult struct {
+	// @Description Position of the operation in the request
+	Index int `json:"index" example:"0"`
+
+	// @Description Outcome of the operation
+	Status string `json:"status" enums:"created,updated,deleted,failed,rolled_back" example:"created"`
+
+	// @Description ID of the affected birthday
+	ID *uuid.UUID `json:"id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// @Description Validation or processing error, for failed operations
+	Error string `json:"error,omitempty" example:"invalid day for month 4"`
+}
+
+// BulkBirthdayResponse represents the outcome of a bulk request
+// @Description Response model for bulk birthday operations
+type BulkBirthdayResponse struct {
+	// @Description Whether any changes were committed
+	Committed bool `json:"committed" example:"true"`
+
+	// @Description Per-operation results, in request order
+	Results []BulkBirthdayResult `json:"results"`
+}
+
+// DuplicateGroupResponse represents birthdays that probably belong to the same person
+// @Description Group of likely duplicate birthdays
+type DuplicateGroupResponse struct {
+	// @Description Shared birth month
+	BirthMonth int `json:"birth_month" example:"5"`
+
+	// @Description Shared birth day
+	BirthDay int `json:"birth_day" example:"15"`
+
+	// @Description Birthdays in the group, oldest first
+	Birthdays []*BirthdayResponse `json:"birthdays"`
+}
+
+// MergeBirthdaysRequest represents the request for merging birthdays into a survivor
+// @Description Request model for merging duplicate birthdays
+type MergeBirthdaysRequest struct {
+	// @Description ID of the birthday that is kept
+	SurvivorID uuid.UUID `json:"survivor_id" binding:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// @Description IDs of the birthdays merged into the survivor; they are deleted afterwards
+	SourceIDs []uuid.UUID `json:"source_ids" binding:"required,min=1,max=50" example:"550e8400-e29b-41d4-a716-446655440006"`
+
+	// @Description Which birthday each conflicting field is taken from; fields that are omitted keep the survivor's value
+	Fields MergeFieldChoices `json:"fields"`
+}
+
+// MergeFieldChoices names the birthday each merged field is taken from
+// @Description Source birthday for each conflicting field
+type MergeFieldChoices struct {
+	// @Description Birthday whose name is kept
+	Name *uuid.UUID `json:"name,omitempty" example:"550e8400-e29b-41d4-a716-446655440006"`
+
+	// @Description Birthday whose birth date (including the year, if any) is kept
+	BirthDate *uuid.UUID `json:"birth_date,omitempty" example:"550e8400-e29b-41d4-a716-446655440006"`
+
+	// @Description Birthday whose category is kept
+	Category *uuid.UUID `json:"category,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestObservedDate(t *testing.T) {
+	leapDay := &Birthday{BirthMonth: 2, BirthDay: 29}
+	ordinary := &Birthday{BirthMonth: 5, BirthDay: 15}
+
+	tests := []struct {
+		name     string
+		birthday *Birthday
+		year     int
+		policy   LeapDayPolicy
+		want     time.Time
+		wantOK   bool
+	}{
+		{name: "ordinary date", birthday: ordinary, year: 2025, policy: LeapDaySkip, want: date(2025, time.May, 15), wantOK: true},
+		{name: "leap day in leap year feb28", birthday: leapDay, year: 2024, policy: LeapDayFeb28, want: date(2024, time.February, 29), wantOK: true},
+		{name: "leap day in leap year mar1", birthday: leapDay, year: 2024, policy: LeapDayMar1, want: date(2024, time.February, 29), wantOK: true},
+		{name: "leap day in leap year skip", birthday: leapDay, year: 2024, policy: LeapDaySkip, want: date(2024, time.February, 29), wantOK: true},
+		{name: "feb28 policy", birthday: leapDay, year: 2025, policy: LeapDayFeb28, want: date(2025, time.February, 28), wantOK: true},
+		{name: "mar1 policy", birthday: leapDay, year: 2025, policy: LeapDayMar1, want: date(2025, time.March, 1), wantOK: true},
+		{name: "skip policy", birthday: leapDay, year: 2025, policy: LeapDaySkip, wantOK: false},
+		{name: "unset policy observes mar1", birthday: leapDay, year: 2025, want: date(2025, time.March, 1), wantOK: true},
+		{name: "century without leap day", birthday: leapDay, year: 2100, policy: LeapDayFeb28, want: date(2100, time.February, 28), wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.birthday.ObservedDate(tt.year, tt.policy)
+			if ok != tt.wantOK || !got.Equal(tt.want) {
+				t.Errorf("ObservedDate(%d, %q) = %v, %v, want %v, %v", tt.year, tt.policy, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	leapDay := &Birthday{BirthMonth: 2, BirthDay: 29}
+	ordinary := &Birthday{BirthMonth: 5, BirthDay: 15}
+
+	tests := []struct {
+		name     string
+		birthday *Birthday
+		today    time.Time
+		policy   LeapDayPolicy
+		want     time.Time
+	}{
+		{name: "later this year", birthday: ordinary, today: date(2025, time.January, 10), policy: LeapDayMar1, want: date(2025, time.May, 15)},
+		{name: "today", birthday: ordinary, today: date(2025, time.May, 15), policy: LeapDayMar1, want: date(2025, time.May, 15)},
+		{name: "passed this year", birthday: ordinary, today: date(2025, time.May, 16), policy: LeapDayMar1, want: date(2026, time.May, 15)},
+		{name: "leap year", birthday: leapDay, today: date(2024, time.January, 1), policy: LeapDaySkip, want: date(2024, time.February, 29)},
+		{name: "feb28 policy", birthday: leapDay, today: date(2025, time.January, 1), policy: LeapDayFeb28, want: date(2025, time.February, 28)},
+		{name: "mar1 policy", birthday: leapDay, today: date(2025, time.January, 1), policy: LeapDayMar1, want: date(2025, time.March, 1)},
+		{name: "skip policy waits for leap year", birthday: leapDay, today: date(2025, time.January, 1), policy: LeapDaySkip, want: date(2028, time.February, 29)},
+		{name: "feb28 policy on the day", birthday: leapDay, today: date(2025, time.February, 28), policy: LeapDayFeb28, want: date(2025, time.February, 28)},
+		{name: "mar1 policy after the day", birthday: leapDay, today: date(2025, time.March, 2), policy: LeapDayMar1, want: date(2026, time.March, 1)},
+		{name: "skip policy over a century", birthday: leapDay, today: date(2097, time.March, 1), policy: LeapDaySkip, want: date(2104, time.February, 29)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.birthday.NextOccurrence(Calendar{Today: tt.today, LeapDayPolicy: tt.policy})
+			if !got.Equal(tt.want) {
+				t.Errorf("NextOccurrence() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
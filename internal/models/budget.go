@@ -0,0 +1,184 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Budget scopes. A yearly budget covers all gifts of the year, a category
+// budget the gifts for the birthdays in one category and a birthday budget
+// the gifts for one person.
+const (
+	BudgetScopeYear     = "year"
+	BudgetScopeCategory = "category"
+	BudgetScopeBirthday = "birthday"
+)
+
+// CreateBudgetRequest represents the request for creating or updating a budget
+// @Description Request model for creating or updating a gift budget
+type CreateBudgetRequest struct {
+	// @Description Calendar year the budget is for
+	Year int `json:"year" binding:"required" example:"2025"`
+
+	// @Description Category the budget is for; omit both category_id and birthday_id for a yearly budget
+	CategoryID *uuid.UUID `json:"category_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
+
+	// @Description Birthday the budget is for; omit both category_id and birthday_id for a yearly budget
+	BirthdayID *uuid.UUID `json:"birthday_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// @Description Budgeted amount as a decimal string with at most two decimal places
+	Amount Amount `json:"amount" swaggertype:"string" example:"500.00"`
+
+	// @Description ISO 4217 currency code of the amount
+	Currency string `json:"currency" binding:"required" example:"EUR"`
+}
+
+// Budget is the amount a user plans to spend on gifts in a year, overall,
+// for a category or for one person. There is at most one budget per scope,
+// year and currency.
+// @Description Budget model
+type Budget struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440014"`
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;index;uniqueIndex:idx_budgets_year_scope,priority:1,where:category_id IS NULL AND birthday_id IS NULL" json:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	User       User       `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
+	Year       int        `gorm:"not null;uniqueIndex:idx_budgets_year_scope,priority:2;uniqueIndex:idx_budgets_category_scope,priority:2;uniqueIndex:idx_budgets_birthday_scope,priority:2" json:"year" example:"2025"`
+	CategoryID *uuid.UUID `gorm:"type:uuid;index;uniqueIndex:idx_budgets_category_scope,priority:1,where:category_id IS NOT NULL" json:"category_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	Category   *Category  `gorm:"foreignKey:CategoryID;constraint:OnDelete:CASCADE" json:"-"`
+	BirthdayID *uuid.UUID `gorm:"type:uuid;index;uniqueIndex:idx_budgets_birthday_scope,priority:1,where:birthday_id IS NOT NULL" json:"birthday_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Birthday   *Birthday  `gorm:"foreignKey:BirthdayID;constraint:OnDelete:CASCADE" json:"-"`
+	Amount     Amount     `gorm:"not null" json:"amount" swaggertype:"string" example:"500.00"`
+	Currency   string     `gorm:"size:3;not null;uniqueIndex:idx_budgets_year_scope,priority:3;uniqueIndex:idx_budgets_category_scope,priority:3;uniqueIndex:idx_budgets_birthday_scope,priority:3" json:"currency" example:"EUR"`
+	CreatedAt  time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt  time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// Scope returns what the budget covers: BudgetScopeYear, BudgetScopeCategory
+// or BudgetScopeBirthday
+func (b *Budget) Scope() string {
+	switch {
+	case b.CategoryID != nil:
+		return BudgetScopeCategory
+	case b.BirthdayID != nil:
+		return BudgetScopeBirthday
+	default:
+		return BudgetScopeYear
+	}
+}
+
+// BudgetResponse represents the response for budget operations
+// @Description Response model for budget operations
+type BudgetResponse struct {
+	// @Description Unique identifier for the budget
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440014"`
+
+	// @Description What the budget covers
+	Scope string `json:"scope" enums:"year,category,birthday" example:"category"`
+
+	// @Description Calendar year the budget is for
+	Year int `json:"year" example:"2025"`
+
+	// @Description Category the budget is for (category budgets only)
+	CategoryID *uuid.UUID `json:"category_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
+
+	// @Description Birthday the budget is for (birthday budgets only)
+	BirthdayID *uuid.UUID `json:"birthday_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// @Description Budgeted amount as a decimal string
+	Amount Amount `json:"amount" swaggertype:"string" example:"500.00"`
+
+	// @Description ISO 4217 currency code of the amount
+	Currency string `json:"currency" example:"EUR"`
+
+	// @Description When the budget was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// @Description When the budget was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToResponse converts Budget model to BudgetResponse
+func (b *Budget) ToResponse() *BudgetResponse {
+	return &BudgetResponse{
+		ID:         b.ID,
+		Scope:      b.Scope(),
+		Year:       b.Year,
+		CategoryID: b.CategoryID,
+		BirthdayID: b.BirthdayID,
+		Amount:     b.Amount,
+		Currency:   b.Currency,
+		CreatedAt:  b.CreatedAt,
+		UpdatedAt:  b.UpdatedAt,
+	}
+}
+
+// SpendingAmounts represents planned and actual gift spending in one currency
+// @Description Planned and actual gift spending in one currency
+type SpendingAmounts struct {
+	// @Description ISO 4217 currency code; amounts in different currencies are never added up
+	Currency string `json:"currency" example:"EUR"`
+
+	// @Description Budgeted amount, if a budget was set for this currency
+	Budget *Amount `json:"budget,omitempty" swaggertype:"string" example:"500.00"`
+
+	// @Description Sum of the estimated prices of the gifts
+	Estimated Amount `json:"estimated" swaggertype:"string" example:"320.50"`
+
+	// @Description Sum of the amounts actually spent on the gifts
+	Actual Amount `json:"actual" swaggertype:"string" example:"289.99"`
+}
+
+// MonthSpending represents the gift spending for the birthdays in one month
+// @Description Gift spending for the birthdays observed in one month
+type MonthSpending struct {
+	// @Description Month (1-12)
+	Month int `json:"month" example:"5"`
+
+	// @Description Amounts per currency
+	Amounts []*SpendingAmounts `json:"amounts"`
+}
+
+// CategorySpending represents the gift spending for the birthdays in one category
+// @Description Gift spending for the birthdays in one category
+type CategorySpending struct {
+	// @Description Category ID
+	CategoryID uuid.UUID `json:"category_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+
+	// @Description Category name
+	Category string `json:"category" example:"Family"`
+
+	// @Description Amounts per currency
+	Amounts []*SpendingAmounts `json:"amounts"`
+}
+
+// PersonSpending represents the gift spending for one birthday
+// @Description Gift spending for one person
+type PersonSpending struct {
+	// @Description Birthday ID
+	BirthdayID uuid.UUID `json:"birthday_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// @Description Name of the person
+	Name string `json:"name" example:"John Doe"`
+
+	// @Description Amounts per currency
+	Amounts []*SpendingAmounts `json:"amounts"`
+}
+
+// SpendingReportResponse represents planned versus actual gift spending in a year
+// @Description Planned versus actual gift spending in a year
+type SpendingReportResponse struct {
+	// @Description Calendar year of the report
+	Year int `json:"year" example:"2025"`
+
+	// @Description Totals per currency, with the yearly budget
+	Totals []*SpendingAmounts `json:"totals"`
+
+	// @Description Spending per month of the birthday, for months with any amounts
+	ByMonth []*MonthSpending `json:"by_month"`
+
+	// @Description Spending per category, in category order, for categories with any amounts or budgets
+	ByCategory []*CategorySpending `json:"by_category"`
+
+	// @Description Spending per person, ordered by name, for people with any amounts or budgets
+	ByPerson []*PersonSpending `json:"by_person"`
+}
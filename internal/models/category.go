@@ -0,0 +1,102 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateCategoryRequest represents the request for creating or updating a category
+// @Description Request model for creating or updating a birthday category
+type CreateCategoryRequest struct {
+	// @Description Name of the category (unique per user, case-insensitive)
+	Name string `json:"name" binding:"required,max=50" example:"Family"`
+
+	// @Description Optional display color (hex, e.g. "#FF8800")
+	Color string `json:"color,omitempty" binding:"omitempty,hexcolor" example:"#FF8800"`
+
+	// @Description Optional icon identifier used by clients
+	Icon string `json:"icon,omitempty" binding:"omitempty,max=50" example:"house"`
+
+	// @Description Position of the category in lists (lower comes first)
+	SortOrder int `json:"sort_order" example:"1"`
+}
+
+// MergeCategoriesRequest represents the request for merging categories into another one
+// @Description Request model for merging categories
+type MergeCategoriesRequest struct {
+	// @Description IDs of the categories to merge into the target; they are deleted afterwards
+	SourceIDs []uuid.UUID `json:"source_ids" binding:"required,min=1" example:"550e8400-e29b-41d4-a716-446655440002"`
+}
+
+// Category represents a user-defined birthday category
+// @Description Category model for grouping birthdays
+type Category struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	UserID         uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_categories_user_name" json:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	User           User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
+	Name           string    `gorm:"size:50;not null" json:"name" example:"Family"`
+	NormalizedName string    `gorm:"size:50;not null;uniqueIndex:idx_categories_user_name" json:"-"`
+	Color          string    `gorm:"size:7" json:"color" example:"#FF8800"`
+	Icon           string    `gorm:"size:50" json:"icon" example:"house"`
+	SortOrder      int       `gorm:"not null;default:0" json:"sort_order" example:"1"`
+	CreatedAt      time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// CategoryResponse represents the response for category operations
+// @Description Response model for category operations
+type CategoryResponse struct {
+	// @Description Unique identifier for the category
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440002"`
+
+	// @Description Name of the category
+	Name string `json:"name" example:"Family"`
+
+	// @Description Display color (hex)
+	Color string `json:"color,omitempty" example:"#FF8800"`
+
+	// @Description Icon identifier
+	Icon string `json:"icon,omitempty" example:"house"`
+
+	// @Description Position of the category in lists
+	SortOrder int `json:"sort_order" example:"1"`
+
+	// @Description When the category was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// @Description When the category was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CategoryStatsResponse represents a category with its birthday statistics
+// @Description Category with the number of birthdays in it and the next upcoming one
+type CategoryStatsResponse struct {
+	CategoryResponse
+
+	// @Description Number of birthdays in the category
+	BirthdayCount int `json:"birthday_count" example:"12"`
+
+	// @Description Next birthday observed in the category, null when the category is empty
+	NextBirthday *UpcomingBirthdayResponse `json:"next_birthday"`
+}
+
+// ToResponse converts Category model to CategoryResponse
+func (c *Category) ToResponse() *CategoryResponse {
+	return &CategoryResponse{
+		ID:        c.ID,
+		Name:      c.Name,
+		Color:     c.Color,
+		Icon:      c.Icon,
+		SortOrder: c.SortOrder,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// NormalizeCategoryName returns the key used to compare category names:
+// trimmed and lower-cased, so "Family" and " family " are the same category.
+func NormalizeCategoryName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
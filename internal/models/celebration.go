@@ -0,0 +1,325 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RSVP statuses of celebration guests
+const (
+	RSVPPending  = "pending"
+	RSVPAccepted = "accepted"
+	RSVPDeclined = "declined"
+	RSVPMaybe    = "maybe"
+)
+
+// RSVPStatuses lists the RSVP statuses
+var RSVPStatuses = []string{RSVPPending, RSVPAccepted, RSVPDeclined, RSVPMaybe}
+
+// CreateCelebrationRequest represents the request for creating or updating a celebration
+// @Description Request model for creating or updating a celebration
+type CreateCelebrationRequest struct {
+	// @Description Year of the birthday occurrence that is celebrated
+	Year int `json:"year" binding:"required" example:"2025"`
+
+	// @Description Date of the celebration (format: YYYY-MM-DD), defaults to the birthday in that year; it must be in that year or within 31 days of the birthday
+	Date string `json:"date,omitempty" example:"2025-05-17"`
+
+	// @Description Where the celebration takes place
+	Location string `json:"location,omitempty" example:"Garden restaurant, Main Street 5"`
+
+	// @Description Optional notes
+	Notes string `json:"notes,omitempty" example:"Surprise party, don't tell John"`
+}
+
+// Celebration is a party or other celebration of one occurrence of a
+// birthday, with a task checklist and a guest list
+// @Description Celebration model
+type Celebration struct {
+	ID         uuid.UUID          `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440016"`
+	BirthdayID uuid.UUID          `gorm:"type:uuid;not null;index" json:"birthday_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Birthday   Birthday           `gorm:"foreignKey:BirthdayID;constraint:OnDelete:CASCADE" json:"-"`
+	Year       int                `gorm:"not null" json:"year" example:"2025"`
+	Date       time.Time          `gorm:"type:date;not null;index" json:"date"`
+	Location   string             `gorm:"size:255" json:"location" example:"Garden restaurant, Main Street 5"`
+	Notes      string             `gorm:"type:text" json:"notes" example:"Surprise party, don't tell John"`
+	Tasks      []CelebrationTask  `gorm:"foreignKey:CelebrationID;constraint:OnDelete:CASCADE" json:"-"`
+	Guests     []CelebrationGuest `gorm:"foreignKey:CelebrationID;constraint:OnDelete:CASCADE" json:"-"`
+	CreatedAt  time.Time          `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt  time.Time          `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// CreateCelebrationTaskRequest represents the request for creating or updating a task
+// @Description Request model for creating or updating a celebration task
+type CreateCelebrationTaskRequest struct {
+	// @Description What needs to be done
+	Title string `json:"title" binding:"required" example:"Order the cake"`
+
+	// @Description Whether the task is done
+	Done bool `json:"done" example:"false"`
+}
+
+// CelebrationTask is an item of the checklist of a celebration
+// @Description Celebration task model
+type CelebrationTask struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440017"`
+	CelebrationID uuid.UUID `gorm:"type:uuid;not null;index" json:"celebration_id" example:"550e8400-e29b-41d4-a716-446655440016"`
+	Title         string    `gorm:"size:200;not null" json:"title" example:"Order the cake"`
+	Done          bool      `gorm:"not null;default:false" json:"done" example:"false"`
+	CreatedAt     time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt     time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// CreateCelebrationGuestRequest represents the request for creating or updating a guest
+// @Description Request model for creating or updating a celebration guest
+type CreateCelebrationGuestRequest struct {
+	// @Description Name of the guest
+	Name string `json:"name" binding:"required" example:"Jane Smith"`
+
+	// @Description How to reach the guest, e.g. an email address or phone number
+	Contact string `json:"contact,omitempty" example:"jane@example.com"`
+
+	// @Description RSVP status, defaults to pending for new guests and is kept when omitted on updates
+	RSVPStatus string `json:"rsvp_status,omitempty" enums:"pending,accepted,declined,maybe" example:"pending"`
+}
+
+// CelebrationGuest is a guest invited to a celebration. Guests answer the
+// invitation through a signed RSVP link.
+// @Description Celebration guest model
+type CelebrationGuest struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440018"`
+	CelebrationID uuid.UUID  `gorm:"type:uuid;not null;index" json:"celebration_id" example:"550e8400-e29b-41d4-a716-446655440016"`
+	Name          string     `gorm:"size:100;not null" json:"name" example:"Jane Smith"`
+	Contact       string     `gorm:"size:255" json:"contact" example:"jane@example.com"`
+	RSVPStatus    string     `gorm:"column:rsvp_status;size:10;not null;default:'pending'" json:"rsvp_status" example:"accepted"`
+	RSVPMessage   string     `gorm:"column:rsvp_message;type:text" json:"rsvp_message" example:"Looking forward to it!"`
+	RespondedAt   *time.Time `json:"responded_at"`
+	CreatedAt     time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// CelebrationTaskResponse represents the response for task operations
+// @Description Response model for celebration tasks
+type CelebrationTaskResponse struct {
+	// @Description Unique identifier for the task
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440017"`
+
+	// @Description What needs to be done
+	Title string `json:"title" example:"Order the cake"`
+
+	// @Description Whether the task is done
+	Done bool `json:"done" example:"false"`
+
+	// @Description When the task was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// @Description When the task was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToResponse converts CelebrationTask model to CelebrationTaskResponse
+func (t *CelebrationTask) ToResponse() *CelebrationTaskResponse {
+	return &CelebrationTaskResponse{
+		ID:        t.ID,
+		Title:     t.Title,
+		Done:      t.Done,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// CelebrationGuestResponse represents the response for guest operations
+// @Description Response model for celebration guests
+type CelebrationGuestResponse struct {
+	// @Description Unique identifier for the guest
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440018"`
+
+	// @Description Name of the guest
+	Name string `json:"name" example:"Jane Smith"`
+
+	// @Description How to reach the guest
+	Contact string `json:"contact,omitempty" example:"jane@example.com"`
+
+	// @Description RSVP status
+	RSVPStatus string `json:"rsvp_status" enums:"pending,accepted,declined,maybe" example:"accepted"`
+
+	// @Description Message the guest left with the answer
+	RSVPMessage string `json:"rsvp_message,omitempty" example:"Looking forward to it!"`
+
+	// @Description When the guest last answered through the RSVP link
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+
+	// @Description Public link the guest can answer the invitation with, without an account
+	RSVPURL string `json:"rsvp_url,omitempty" example:"https://api.example.com/api/v1/rsvp/550e8400-e29b-41d4-a716-446655440018.3f2a..."`
+
+	// @Description When the guest was added
+	CreatedAt time.Time `json:"created_at"`
+
+	// @Description When the guest was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToResponse converts CelebrationGuest model to CelebrationGuestResponse
+func (g *CelebrationGuest) ToResponse() *CelebrationGuestResponse {
+	return &CelebrationGuestResponse{
+		ID:          g.ID,
+		Name:        g.Name,
+		Contact:     g.Contact,
+		RSVPStatus:  g.RSVPStatus,
+		RSVPMessage: g.RSVPMessage,
+		RespondedAt: g.RespondedAt,
+		CreatedAt:   g.CreatedAt,
+		UpdatedAt:   g.UpdatedAt,
+	}
+}
+
+// RSVPSummary counts the guests of a celebration per RSVP status
+// @Description Number of guests per RSVP status
+type RSVPSummary struct {
+	Pending  int `json:"pending" example:"3"`
+	Accepted int `json:"accepted" example:"12"`
+	Declined int `json:"declined" example:"2"`
+	Maybe    int `json:"maybe" example:"1"`
+}
+
+// CelebrationResponse represents the response for celebration operations
+// @Description Response model for celebration operations
+type CelebrationResponse struct {
+	// @Description Unique identifier for the celebration
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440016"`
+
+	// @Description Birthday that is celebrated
+	BirthdayID uuid.UUID `json:"birthday_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// @Description Name of the person, or of both people of an anniversary
+	Name string `json:"name" example:"John Doe"`
+
+	// @Description Year of the birthday occurrence that is celebrated
+	Year int `json:"year" example:"2025"`
+
+	// @Description Kind of event that is celebrated
+	EventType string `json:"event_type" example:"birthday"`
+
+	// @Description Age the person turns in that year (only present for birthdays when the birth year is known)
+	TurningAge *int `json:"turning_age,omitempty" example:"40"`
+
+	// @Description Years completed in that year, worded by count_label (only present when the year is known and the event type counts years)
+	TurningYears *int `json:"turning_years,omitempty" example:"40"`
+
+	// @Description Wording of turning_years for the event type, e.g. "age" or "years married"
+	CountLabel string `json:"count_label,omitempty" example:"age"`
+
+	// @Description Date of the celebration (format: YYYY-MM-DD)
+	Date string `json:"date" example:"2025-05-17"`
+
+	// @Description Where the celebration takes place
+	Location string `json:"location,omitempty" example:"Garden restaurant, Main Street 5"`
+
+	// @Description Notes
+	Notes string `json:"notes,omitempty" example:"Surprise party, don't tell John"`
+
+	// @Description Task checklist, oldest first
+	Tasks []*CelebrationTaskResponse `json:"tasks"`
+
+	// @Description Guest list, ordered by name
+	Guests []*CelebrationGuestResponse `json:"guests"`
+
+	// @Description Number of guests per RSVP status
+	RSVP RSVPSummary `json:"rsvp"`
+
+	// @Description When the celebration was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// @Description When the celebration was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToResponse converts Celebration model to CelebrationResponse. birthday is
+// the birthday that is celebrated; Tasks and Guests must be loaded.
+func (c *Celebration) ToResponse(birthday *Birthday) *CelebrationResponse {
+	eventType := birthday.Type()
+	response := &CelebrationResponse{
+		ID:         c.ID,
+		BirthdayID: c.BirthdayID,
+		Name:       birthday.DisplayName(),
+		EventType:  eventType.Key,
+		CountLabel: eventType.CountLabel,
+		Year:       c.Year,
+		Date:       c.Date.Format("2006-01-02"),
+		Location:   c.Location,
+		Notes:      c.Notes,
+		Tasks:      make([]*CelebrationTaskResponse, len(c.Tasks)),
+		Guests:     make([]*CelebrationGuestResponse, len(c.Guests)),
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+	response.TurningYears = birthday.YearsIn(c.Year)
+	if eventType.Key == EventTypeBirthday {
+		response.TurningAge = response.TurningYears
+	}
+	for i := range c.Tasks {
+		response.Tasks[i] = c.Tasks[i].ToResponse()
+	}
+	for i := range c.Guests {
+		response.Guests[i] = c.Guests[i].ToResponse()
+		switch c.Guests[i].RSVPStatus {
+		case RSVPAccepted:
+			response.RSVP.Accepted++
+		case RSVPDeclined:
+			response.RSVP.Declined++
+		case RSVPMaybe:
+			response.RSVP.Maybe++
+		default:
+			response.RSVP.Pending++
+		}
+	}
+	return response
+}
+
+// RSVPRequest represents a guest's answer to an invitation
+// @Description Request model for answering an invitation through an RSVP link
+type RSVPRequest struct {
+	// @Description Answer to the invitation
+	Status string `json:"status" binding:"required" enums:"accepted,declined,maybe" example:"accepted"`
+
+	// @Description Optional message for the host
+	Message string `json:"message,omitempty" example:"Looking forward to it!"`
+}
+
+// RSVPResponse represents an invitation as seen by the guest
+// @Description Invitation details shown to a guest through an RSVP link
+type RSVPResponse struct {
+	// @Description Name of the guest
+	Guest string `json:"guest" example:"Jane Smith"`
+
+	// @Description Name of the person whose event is celebrated, or of both people of an anniversary
+	Name string `json:"name" example:"John Doe"`
+
+	// @Description Kind of event that is celebrated
+	EventType string `json:"event_type" example:"birthday"`
+
+	// @Description Age the person turns (only present for birthdays when the birth year is known)
+	TurningAge *int `json:"turning_age,omitempty" example:"40"`
+
+	// @Description Years completed, worded by count_label (only present when the year is known and the event type counts years)
+	TurningYears *int `json:"turning_years,omitempty" example:"40"`
+
+	// @Description Wording of turning_years for the event type, e.g. "age" or "years married"
+	CountLabel string `json:"count_label,omitempty" example:"age"`
+
+	// @Description Date of the celebration (format: YYYY-MM-DD)
+	Date string `json:"date" example:"2025-05-17"`
+
+	// @Description Where the celebration takes place
+	Location string `json:"location,omitempty" example:"Garden restaurant, Main Street 5"`
+
+	// @Description Current answer of the guest
+	Status string `json:"status" enums:"pending,accepted,declined,maybe" example:"pending"`
+
+	// @Description Message the guest left with the answer
+	Message string `json:"message,omitempty" example:"Looking forward to it!"`
+
+	// @Description When the guest last answered
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+}
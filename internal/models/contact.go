@@ -0,0 +1,124 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// Contact detail types
+const (
+	ContactTypeMobile   = "mobile"
+	ContactTypeHome     = "home"
+	ContactTypeWork     = "work"
+	ContactTypePersonal = "personal"
+	ContactTypeOther    = "other"
+)
+
+// PhoneNumber is a typed phone number
+// @Description Phone number of a person
+type PhoneNumber struct {
+	// @Description Kind of number (default: other)
+	Type string `json:"type" enums:"mobile,home,work,other" example:"mobile"`
+
+	// @Description Phone number with optional leading + and country code; spaces, dashes, dots and parentheses are allowed
+	Number string `json:"number" example:"+49 30 1234567"`
+}
+
+// EmailAddress is a typed email address
+// @Description Email address of a person
+type EmailAddress struct {
+	// @Description Kind of address (default: other)
+	Type string `json:"type" enums:"personal,work,other" example:"personal"`
+
+	// @Description Email address
+	Address string `json:"address" example:"john@example.com"`
+}
+
+// PostalAddress is a typed postal address
+// @Description Postal address of a person; at least one field besides type must be set
+type PostalAddress struct {
+	// @Description Kind of address (default: other)
+	Type string `json:"type" enums:"home,work,other" example:"home"`
+
+	Address
+}
+
+// Address is a postal address
+// @Description Postal address
+type Address struct {
+	// @Description Street and house number, may span several lines
+	Street string `json:"street,omitempty" example:"Hauptstraße 1"`
+
+	// @Description City or town
+	City string `json:"city,omitempty" example:"Berlin"`
+
+	// @Description State, province or region
+	Region string `json:"region,omitempty" example:"Berlin"`
+
+	// @Description Postal code
+	PostalCode string `json:"postal_code,omitempty" example:"10115"`
+
+	// @Description ISO 3166-1 alpha-2 country code
+	Country string `json:"country,omitempty" example:"DE"`
+}
+
+// SocialHandle is a user name on a social network
+// @Description Social network handle of a person
+type SocialHandle struct {
+	// @Description Network name in lower case, e.g. instagram, x, mastodon, linkedin
+	Network string `json:"network" example:"instagram"`
+
+	// @Description User name on the network, without a leading @
+	Handle string `json:"handle" example:"johndoe"`
+}
+
+// ContactDetails holds the contact information stored with a birthday
+type ContactDetails struct {
+	Phones        []PhoneNumber   `json:"phones,omitempty"`
+	Emails        []EmailAddress  `json:"emails,omitempty"`
+	Addresses     []PostalAddress `json:"addresses,omitempty"`
+	SocialHandles []SocialHandle  `json:"social_handles,omitempty"`
+}
+
+// Value stores the contact details as JSON
+func (c ContactDetails) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// Scan reads contact details stored as JSON
+func (c *ContactDetails) Scan(value interface{}) error {
+	return scanJSON(value, c)
+}
+
+// SearchText returns the contact details as lower-case text for searching.
+// Phone numbers are included once more with only their digits, so that a
+// search matches regardless of formatting.
+func (c *ContactDetails) SearchText() string {
+	var values []string
+	for _, phone := range c.Phones {
+		values = append(values, phone.Number, PhoneDigits(phone.Number))
+	}
+	for _, email := range c.Emails {
+		values = append(values, email.Address)
+	}
+	for _, address := range c.Addresses {
+		values = append(values, address.Street, address.City, address.Region, address.PostalCode, address.Country)
+	}
+	for _, social := range c.SocialHandles {
+		values = append(values, social.Handle)
+	}
+	values = slices.DeleteFunc(values, func(value string) bool { return value == "" })
+	return strings.ToLower(strings.Join(values, "\n"))
+}
+
+// PhoneDigits returns the digits of a phone number
+func PhoneDigits(number string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, number)
+}
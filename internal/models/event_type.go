@@ -0,0 +1,139 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Event types
+const (
+	EventTypeBirthday           = "birthday"
+	EventTypeWeddingAnniversary = "wedding_anniversary"
+	EventTypeAnniversary        = "anniversary"
+	EventTypeWorkAnniversary    = "work_anniversary"
+	EventTypeMemorial           = "memorial"
+	EventTypeNameDay            = "name_day"
+	EventTypeOther              = "other"
+)
+
+// EventType describes a kind of yearly recurring event and how it is worded
+// @Description Kind of yearly recurring event
+type EventType struct {
+	// @Description Identifier used as event_type
+	Key string `json:"key" example:"wedding_anniversary"`
+
+	// @Description Display name
+	Name string `json:"name" example:"Wedding anniversary"`
+
+	// @Description Icon shown next to events of this type
+	Icon string `json:"icon" example:"💍"`
+
+	// @Description Wording of the number of years since the first occurrence, omitted when years are not counted
+	CountLabel string `json:"count_label,omitempty" example:"years married"`
+
+	// @Description Whether events of this type involve two people (see partner_name)
+	Couple bool `json:"couple" example:"true"`
+
+	// title is the calendar summary of an event, %s being the name
+	title string
+	// since introduces the year of the first occurrence in calendar entries
+	since string
+}
+
+// EventTypes lists the event types, birthdays first
+var EventTypes = []EventType{
+	{Key: EventTypeBirthday, Name: "Birthday", Icon: "🎂", CountLabel: "age", title: "%s's birthday", since: "Born"},
+	{Key: EventTypeWeddingAnniversary, Name: "Wedding anniversary", Icon: "💍", CountLabel: "years married", Couple: true, title: "%s's wedding anniversary", since: "Married"},
+	{Key: EventTypeAnniversary, Name: "Anniversary", Icon: "❤️", CountLabel: "years together", Couple: true, title: "%s's anniversary", since: "Together since"},
+	{Key: EventTypeWorkAnniversary, Name: "Work anniversary", Icon: "💼", CountLabel: "years at work", title: "%s's work anniversary", since: "Started"},
+	{Key: EventTypeMemorial, Name: "Memorial", Icon: "🕯️", CountLabel: "years since passing", title: "In memory of %s", since: "Passed away"},
+	{Key: EventTypeNameDay, Name: "Name day", Icon: "📛", title: "%s's name day"},
+	{Key: EventTypeOther, Name: "Other", Icon: "📅", CountLabel: "years", title: "%s (yearly)", since: "Since"},
+}
+
+// LookupEventType returns the event type with the given key
+func LookupEventType(key string) (*EventType, bool) {
+	for i := range EventTypes {
+		if EventTypes[i].Key == key {
+			return &EventTypes[i], true
+		}
+	}
+	return nil, false
+}
+
+// Counted reports whether the years since the first occurrence are shown for
+// events of this type
+func (t *EventType) Counted() bool {
+	return t.CountLabel != ""
+}
+
+// Title returns the calendar summary of an event of this type for name
+func (t *EventType) Title(name string) string {
+	return fmt.Sprintf(t.title, name)
+}
+
+// ParseTitle reverses Title: it returns the name in a calendar summary such
+// as "Jane's birthday" and the event type the summary was written for, with
+// the names of both people of a couple split at " & ". ok is false when the
+// summary matches no event type.
+func ParseTitle(summary string) (name, partnerName string, eventType *EventType, ok bool) {
+	summary = strings.TrimSpace(summary)
+	for i := range EventTypes {
+		// Calendar apps often write a typographic apostrophe.
+		for _, title := range []string{EventTypes[i].title, strings.ReplaceAll(EventTypes[i].title, "'", "\u2019")} {
+			prefix, suffix, _ := strings.Cut(title, "%s")
+			if len(summary) <= len(prefix)+len(suffix) ||
+				!strings.EqualFold(summary[:len(prefix)], prefix) ||
+				!strings.EqualFold(summary[len(summary)-len(suffix):], suffix) {
+				continue
+			}
+
+			name = strings.TrimSpace(summary[len(prefix) : len(summary)-len(suffix)])
+			if EventTypes[i].Couple {
+				if first, second, found := strings.Cut(name, " & "); found {
+					name, partnerName = strings.TrimSpace(first), strings.TrimSpace(second)
+				}
+			}
+			return name, partnerName, &EventTypes[i], true
+		}
+	}
+	return summary, "", nil, false
+}
+
+// Since returns the line introducing the year of the first occurrence in
+// calendar entries, or "" when years are not counted
+func (t *EventType) Since(year int) string {
+	if t.since == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s %d", t.since, year)
+}
+
+// Type returns the event type of the birthday. Records saved before event
+// types existed are birthdays.
+func (b *Birthday) Type() *EventType {
+	if eventType, ok := LookupEventType(b.EventType); ok {
+		return eventType
+	}
+	return &EventTypes[0]
+}
+
+// DisplayName returns the name of the birthday, joined with the partner's
+// for anniversaries of two people
+func (b *Birthday) DisplayName() string {
+	if b.PartnerName != "" {
+		return b.Name + " & " + b.PartnerName
+	}
+	return b.Name
+}
+
+// YearsIn returns the number of years completed by the occurrence in year,
+// such as the age turned on a birthday. It is nil when the year of the first
+// occurrence is unknown or the event type does not count years.
+func (b *Birthday) YearsIn(year int) *int {
+	if b.BirthYear == nil || !b.Type().Counted() {
+		return nil
+	}
+	years := year - *b.BirthYear
+	return &years
+}
@@ -0,0 +1,88 @@
+package models
+
+import "testing"
+
+func TestParseTitleRoundTrip(t *testing.T) {
+	for i := range EventTypes {
+		eventType := &EventTypes[i]
+		t.Run(eventType.Key, func(t *testing.T) {
+			name, partnerName, parsed, ok := ParseTitle(eventType.Title("Jane Doe"))
+			if !ok || parsed.Key != eventType.Key || name != "Jane Doe" || partnerName != "" {
+				t.Errorf("ParseTitle(%q) = %q, %q, %v, %v, want %q, \"\", %s, true",
+					eventType.Title("Jane Doe"), name, partnerName, parsed, ok, "Jane Doe", eventType.Key)
+			}
+		})
+	}
+}
+
+func TestParseTitle(t *testing.T) {
+	tests := []struct {
+		summary         string
+		wantName        string
+		wantPartnerName string
+		wantType        string
+		wantOK          bool
+	}{
+		{summary: "Jane's birthday", wantName: "Jane", wantType: EventTypeBirthday, wantOK: true},
+		{summary: "  Jane Doe's Birthday ", wantName: "Jane Doe", wantType: EventTypeBirthday, wantOK: true},
+		{summary: "Jane’s birthday", wantName: "Jane", wantType: EventTypeBirthday, wantOK: true},
+		{summary: "John & Jane's wedding anniversary", wantName: "John", wantPartnerName: "Jane", wantType: EventTypeWeddingAnniversary, wantOK: true},
+		{summary: "John & Jane’s anniversary", wantName: "John", wantPartnerName: "Jane", wantType: EventTypeAnniversary, wantOK: true},
+		{summary: "John's anniversary", wantName: "John", wantType: EventTypeAnniversary, wantOK: true},
+		{summary: "Tom & Jerry's work anniversary", wantName: "Tom & Jerry", wantType: EventTypeWorkAnniversary, wantOK: true},
+		{summary: "In memory of Grandpa", wantName: "Grandpa", wantType: EventTypeMemorial, wantOK: true},
+		{summary: "Anna's name day", wantName: "Anna", wantType: EventTypeNameDay, wantOK: true},
+		{summary: "Book club (yearly)", wantName: "Book club", wantType: EventTypeOther, wantOK: true},
+		{summary: "Jane", wantName: "Jane", wantOK: false},
+		{summary: "'s birthday", wantName: "'s birthday", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.summary, func(t *testing.T) {
+			name, partnerName, eventType, ok := ParseTitle(tt.summary)
+			if ok != tt.wantOK || name != tt.wantName || partnerName != tt.wantPartnerName {
+				t.Fatalf("ParseTitle(%q) = %q, %q, _, %v, want %q, %q, _, %v", tt.summary, name, partnerName, ok, tt.wantName, tt.wantPartnerName, tt.wantOK)
+			}
+			if ok && eventType.Key != tt.wantType {
+				t.Errorf("ParseTitle(%q) event type = %s, want %s", tt.summary, eventType.Key, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestCounted(t *testing.T) {
+	for i := range EventTypes {
+		eventType := &EventTypes[i]
+		want := eventType.Key != EventTypeNameDay
+		if got := eventType.Counted(); got != want {
+			t.Errorf("%s Counted() = %v, want %v", eventType.Key, got, want)
+		}
+	}
+}
+
+func TestYearsIn(t *testing.T) {
+	year := func(y int) *int { return &y }
+
+	tests := []struct {
+		name     string
+		birthday *Birthday
+		year     int
+		want     *int
+	}{
+		{name: "birthday", birthday: &Birthday{EventType: EventTypeBirthday, BirthYear: year(1990)}, year: 2025, want: year(35)},
+		{name: "record without event type", birthday: &Birthday{EventType: "", BirthYear: year(1990)}, year: 2025, want: year(35)},
+		{name: "wedding anniversary", birthday: &Birthday{EventType: EventTypeWeddingAnniversary, BirthYear: year(2015)}, year: 2025, want: year(10)},
+		{name: "first occurrence", birthday: &Birthday{EventType: EventTypeWorkAnniversary, BirthYear: year(2025)}, year: 2025, want: year(0)},
+		{name: "unknown year", birthday: &Birthday{EventType: EventTypeMemorial}, year: 2025, want: nil},
+		{name: "name day is not counted", birthday: &Birthday{EventType: EventTypeNameDay, BirthYear: year(1990)}, year: 2025, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.birthday.YearsIn(tt.year)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("YearsIn(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+		})
+	}
+}
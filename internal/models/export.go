@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Data export statuses
+const (
+	ExportStatusPending = "pending"
+	ExportStatusRunning = "running"
+	ExportStatusReady   = "ready"
+	ExportStatusFailed  = "failed"
+	ExportStatusExpired = "expired"
+)
+
+// DataExport represents an archive of all of a user's data
+// @Description Data export model
+type DataExport struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440005"`
+	UserID      uuid.UUID  `gorm:"type:uuid;not null;index;uniqueIndex:idx_data_exports_user_in_progress,where:status = 'pending' OR status = 'running'" json:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	User        User       `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
+	Status      string     `gorm:"size:10;not null;index" json:"status" example:"ready"`
+	Error       string     `gorm:"type:text" json:"error,omitempty"`
+	FilePath    string     `gorm:"size:500" json:"-"`
+	Size        int64      `json:"size"`
+	CreatedAt   time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// DataExportResponse represents the response for data export operations
+// @Description Response model for data exports
+type DataExportResponse struct {
+	// @Description Unique identifier for the export
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440005"`
+
+	// @Description Progress of the export
+	Status string `json:"status" enums:"pending,running,ready,failed,expired" example:"ready"`
+
+	// @Description Why the export failed
+	Error string `json:"error,omitempty"`
+
+	// @Description Size of the archive in bytes, once ready
+	Size int64 `json:"size,omitempty" example:"20480"`
+
+	// @Description Time-limited download link, present while the export is ready
+	DownloadURL string `json:"download_url,omitempty" example:"https://example.com/api/v1/exports/550e8400-e29b-41d4-a716-446655440005/download?expires=1735693200&signature=3f1c..."`
+
+	// @Description When the download link stops working
+	DownloadExpiresAt *time.Time `json:"download_expires_at,omitempty"`
+
+	// @Description When the export was requested
+	CreatedAt time.Time `json:"created_at"`
+
+	// @Description When the archive was built
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// @Description When the archive is deleted
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// ToResponse converts a DataExport to DataExportResponse without a download link
+func (e *DataExport) ToResponse() *DataExportResponse {
+	return &DataExportResponse{
+		ID:          e.ID,
+		Status:      e.Status,
+		Error:       e.Error,
+		Size:        e.Size,
+		CreatedAt:   e.CreatedAt,
+		CompletedAt: e.CompletedAt,
+		ExpiresAt:   e.ExpiresAt,
+	}
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CalendarFeedRequest represents the settings of a calendar feed
+// @Description Request model for creating or updating the calendar feed
+type CalendarFeedRequest struct {
+	// @Description Minutes before the start of the birthday (midnight) to show a reminder; negative values remind later that day (e.g. -540 for 09:00). Omit or null for no reminder.
+	ReminderMinutes *int `json:"reminder_minutes" binding:"omitempty,min=-1439,max=40320" example:"-540"`
+}
+
+// CalendarFeed represents a user's iCalendar subscription feed. Only a hash
+// of the secret token is stored.
+// @Description Calendar feed model
+type CalendarFeed struct {
+	ID              uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440004"`
+	UserID          uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	User            User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
+	TokenHash       string    `gorm:"size:64;not null;uniqueIndex" json:"-"`
+	ReminderMinutes *int      `json:"reminder_minutes"`
+	CreatedAt       time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt       time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// CalendarFeedResponse represents the response for calendar feed operations
+// @Description Response model for the calendar feed
+type CalendarFeedResponse struct {
+	// @Description Minutes before the start of the birthday to show a reminder (null for none)
+	ReminderMinutes *int `json:"reminder_minutes" example:"-540"`
+
+	// @Description Secret feed token; only returned when the feed is created or rotated
+	Token string `json:"token,omitempty" example:"kq3V0bN9sQ2xJ7cWm5YhR1tL8pZfA4uE6dG0iKoXy2M"`
+
+	// @Description Subscription URL to add to a calendar app; only returned when the feed is created or rotated
+	URL string `json:"url,omitempty" example:"https://example.com/api/v1/feeds/kq3V0bN9sQ2xJ7cWm5YhR1tL8pZfA4uE6dG0iKoXy2M/birthdays.ics"`
+
+	// @Description When the feed was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// @Description When the feed was last updated or rotated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToResponse converts a CalendarFeed to CalendarFeedResponse without the token
+func (f *CalendarFeed) ToResponse() *CalendarFeedResponse {
+	return &CalendarFeedResponse{
+		ReminderMinutes: f.ReminderMinutes,
+		CreatedAt:       f.CreatedAt,
+		UpdatedAt:       f.UpdatedAt,
+	}
+}
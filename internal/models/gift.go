@@ -0,0 +1,164 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Gift statuses, in lifecycle order. A gift can only move forward through
+// the lifecycle, but may skip steps.
+const (
+	GiftStatusIdea      = "idea"
+	GiftStatusPurchased = "purchased"
+	GiftStatusWrapped   = "wrapped"
+	GiftStatusGiven     = "given"
+)
+
+// GiftStatuses lists the gift statuses in lifecycle order
+var GiftStatuses = []string{GiftStatusIdea, GiftStatusPurchased, GiftStatusWrapped, GiftStatusGiven}
+
+// Gift priorities
+const (
+	GiftPriorityLow    = "low"
+	GiftPriorityMedium = "medium"
+	GiftPriorityHigh   = "high"
+)
+
+// GiftPriorities lists the gift priorities from highest to lowest
+var GiftPriorities = []string{GiftPriorityHigh, GiftPriorityMedium, GiftPriorityLow}
+
+// GiftStatusRank returns the position of status in the lifecycle, or -1 for
+// an unknown status
+func GiftStatusRank(status string) int {
+	for i, s := range GiftStatuses {
+		if s == status {
+			return i
+		}
+	}
+	return -1
+}
+
+// CreateGiftRequest represents the request for creating or updating a gift
+// @Description Request model for creating or updating a gift idea
+type CreateGiftRequest struct {
+	// @Description Short description of the gift
+	Title string `json:"title" binding:"required" example:"Espresso machine"`
+
+	// @Description Optional link to the gift (http or https)
+	URL string `json:"url,omitempty" example:"https://example.com/espresso-machine"`
+
+	// @Description Optional estimated price as a decimal string with at most two decimal places
+	EstimatedPrice *Amount `json:"estimated_price,omitempty" swaggertype:"string" example:"149.99"`
+
+	// @Description Optional amount actually spent, once the gift is purchased
+	ActualPrice *Amount `json:"actual_price,omitempty" swaggertype:"string" example:"139.00"`
+
+	// @Description ISO 4217 currency code of both prices, required with either of them
+	Currency string `json:"currency,omitempty" example:"EUR"`
+
+	// @Description Priority, defaults to medium
+	Priority string `json:"priority,omitempty" enums:"high,medium,low" example:"high"`
+
+	// @Description Status, defaults to idea; statuses can only move forward (idea, purchased, wrapped, given)
+	Status string `json:"status,omitempty" enums:"idea,purchased,wrapped,given" example:"idea"`
+
+	// @Description Year the gift was given; only allowed for given gifts, defaults to the current year
+	GivenYear *int `json:"given_year,omitempty" example:"2024"`
+}
+
+// Gift is a gift idea for a birthday, tracked from idea to given
+// @Description Gift model
+type Gift struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440013"`
+	BirthdayID     uuid.UUID `gorm:"type:uuid;not null;index" json:"birthday_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Birthday       Birthday  `gorm:"foreignKey:BirthdayID;constraint:OnDelete:CASCADE" json:"-"`
+	Title          string    `gorm:"size:200;not null" json:"title" example:"Espresso machine"`
+	URL            string    `gorm:"size:2048" json:"url" example:"https://example.com/espresso-machine"`
+	EstimatedPrice *Amount   `json:"estimated_price" swaggertype:"string" example:"149.99"`
+	ActualPrice    *Amount   `json:"actual_price" swaggertype:"string" example:"139.00"`
+	Currency       string    `gorm:"size:3" json:"currency" example:"EUR"`
+	Priority       string    `gorm:"size:10;not null;default:medium" json:"priority" example:"high"`
+	Status         string    `gorm:"size:10;not null;default:idea;index" json:"status" example:"idea"`
+	GivenYear      *int      `json:"given_year" example:"2024"`
+	CreatedAt      time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// IsOpen reports whether the gift has not been given yet
+func (g *Gift) IsOpen() bool {
+	return g.Status != GiftStatusGiven
+}
+
+// GiftResponse represents the response for gift operations
+// @Description Response model for gift operations
+type GiftResponse struct {
+	// @Description Unique identifier for the gift
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440013"`
+
+	// @Description ID of the birthday the gift is for
+	BirthdayID uuid.UUID `json:"birthday_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// @Description Short description of the gift
+	Title string `json:"title" example:"Espresso machine"`
+
+	// @Description Link to the gift
+	URL string `json:"url,omitempty" example:"https://example.com/espresso-machine"`
+
+	// @Description Estimated price as a decimal string
+	EstimatedPrice *Amount `json:"estimated_price,omitempty" swaggertype:"string" example:"149.99"`
+
+	// @Description Amount actually spent as a decimal string
+	ActualPrice *Amount `json:"actual_price,omitempty" swaggertype:"string" example:"139.00"`
+
+	// @Description ISO 4217 currency code of both prices
+	Currency string `json:"currency,omitempty" example:"EUR"`
+
+	// @Description Priority
+	Priority string `json:"priority" enums:"high,medium,low" example:"high"`
+
+	// @Description Status
+	Status string `json:"status" enums:"idea,purchased,wrapped,given" example:"idea"`
+
+	// @Description Year the gift was given (only present for given gifts)
+	GivenYear *int `json:"given_year,omitempty" example:"2024"`
+
+	// @Description For gifts not given yet: the last year a gift with the same title was given to this person, to avoid repeating it
+	PreviouslyGivenYear *int `json:"previously_given_year,omitempty" example:"2021"`
+
+	// @Description When the gift was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// @Description When the gift was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToResponse converts Gift model to GiftResponse. previouslyGivenYear is the
+// last year a gift with the same title was given, if any.
+func (g *Gift) ToResponse(previouslyGivenYear *int) *GiftResponse {
+	return &GiftResponse{
+		ID:                  g.ID,
+		BirthdayID:          g.BirthdayID,
+		Title:               g.Title,
+		URL:                 g.URL,
+		EstimatedPrice:      g.EstimatedPrice,
+		ActualPrice:         g.ActualPrice,
+		Currency:            g.Currency,
+		Priority:            g.Priority,
+		Status:              g.Status,
+		GivenYear:           g.GivenYear,
+		PreviouslyGivenYear: previouslyGivenYear,
+		CreatedAt:           g.CreatedAt,
+		UpdatedAt:           g.UpdatedAt,
+	}
+}
+
+// UpcomingGiftsResponse represents the open gift ideas for an upcoming birthday
+// @Description Open gift ideas for an upcoming birthday
+type UpcomingGiftsResponse struct {
+	// @Description The upcoming birthday
+	Birthday *UpcomingBirthdayResponse `json:"birthday"`
+
+	// @Description Gifts not given yet, by priority and then oldest first
+	Gifts []*GiftResponse `json:"gifts"`
+}
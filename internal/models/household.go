@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateHouseholdRequest represents the request for creating or updating a household
+// @Description Request model for creating or updating a household
+type CreateHouseholdRequest struct {
+	// @Description Name of the household
+	Name string `json:"name" binding:"required,max=100" example:"The Does"`
+
+	// @Description Optional address shared by the members
+	Address Address `json:"address"`
+}
+
+// Household groups birthdays of people who live together
+// @Description Household model
+type Household struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440012"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	User      User       `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
+	Name      string     `gorm:"size:100;not null" json:"name" example:"The Does"`
+	Address   Address    `gorm:"embedded;embeddedPrefix:address_" json:"address"`
+	Members   []Birthday `gorm:"foreignKey:HouseholdID;constraint:OnDelete:SET NULL" json:"-"`
+	CreatedAt time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// HouseholdResponse represents the response for household operations
+// @Description Response model for household operations
+type HouseholdResponse struct {
+	// @Description Unique identifier for the household
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440012"`
+
+	// @Description Name of the household
+	Name string `json:"name" example:"The Does"`
+
+	// @Description Address shared by the members
+	Address Address `json:"address"`
+
+	// @Description Number of members, not counting birthdays in the trash
+	MemberCount int `json:"member_count" example:"4"`
+
+	// @Description When the household was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// @Description When the household was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToResponse converts Household model to HouseholdResponse
+func (h *Household) ToResponse(memberCount int) *HouseholdResponse {
+	return &HouseholdResponse{
+		ID:          h.ID,
+		Name:        h.Name,
+		Address:     h.Address,
+		MemberCount: memberCount,
+		CreatedAt:   h.CreatedAt,
+		UpdatedAt:   h.UpdatedAt,
+	}
+}
+
+// UpcomingHouseholdResponse represents the upcoming birthdays of one household
+// @Description Upcoming birthdays grouped by household
+type UpcomingHouseholdResponse struct {
+	// @Description Household ID, null for the group of birthdays without a household
+	HouseholdID *uuid.UUID `json:"household_id" example:"550e8400-e29b-41d4-a716-446655440012"`
+
+	// @Description Household name, empty for the group of birthdays without a household
+	HouseholdName string `json:"household_name" example:"The Does"`
+
+	// @Description Upcoming birthdays of the household's members, ordered by next occurrence
+	Birthdays []*UpcomingBirthdayResponse `json:"birthdays"`
+}
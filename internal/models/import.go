@@ -0,0 +1,100 @@
+package models
+
+import "github.com/google/uuid"
+
+// Import row statuses
+const (
+	ImportStatusCreated = "created"
+	ImportStatusUpdated = "updated"
+	ImportStatusSkipped = "skipped"
+	ImportStatusInvalid = "invalid"
+)
+
+// Duplicate policies for imports
+const (
+	DuplicateSkip   = "skip"
+	DuplicateUpdate = "update"
+	DuplicateCreate = "create"
+)
+
+// ImportOptions represents the form fields accepted by every import
+type ImportOptions struct {
+	DryRun          bool   `form:"dry_run"`
+	OnDuplicate     string `form:"on_duplicate" binding:"omitempty,oneof=skip update create"`
+	DefaultCategory string `form:"default_category"`
+}
+
+// ICSImportOptions represents the form fields accepted by the iCalendar import
+type ICSImportOptions struct {
+	ImportOptions
+	IgnoreYear bool `form:"ignore_year"`
+}
+
+// CSVImportOptions represents the form fields accepted by the CSV import
+type CSVImportOptions struct {
+	ImportOptions
+	NameColumn      string `form:"name_column"`
+	BirthDateColumn string `form:"birth_date_column"`
+	CategoryColumn  string `form:"category_column"`
+	NotesColumn     string `form:"notes_column"`
+}
+
+// ImportRowResult represents the outcome of importing a single row or contact
+// @Description Outcome of one imported row
+type ImportRowResult struct {
+	// @Description Line number (CSV) or position (vCard, iCalendar) of the entry in the file, starting at 1
+	Row int `json:"row" example:"2"`
+
+	// @Description Name read from the entry
+	Name string `json:"name,omitempty" example:"John Doe"`
+
+	// @Description Outcome of the entry
+	Status string `json:"status" enums:"created,updated,skipped,invalid" example:"created"`
+
+	// @Description ID of the created or updated birthday (omitted in dry-run mode)
+	ID *uuid.UUID `json:"id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// @Description Validation error or reason for skipping
+	Error string `json:"error,omitempty" example:"invalid day for month 4"`
+}
+
+// ImportReport represents the outcome of an import
+// @Description Import report with per-row results
+type ImportReport struct {
+	// @Description Whether this was a dry run (nothing was saved)
+	DryRun bool `json:"dry_run" example:"false"`
+
+	// @Description Number of entries read from the file
+	Total int `json:"total" example:"10"`
+
+	// @Description Number of birthdays created
+	Created int `json:"created" example:"7"`
+
+	// @Description Number of existing birthdays updated
+	Updated int `json:"updated" example:"1"`
+
+	// @Description Number of entries skipped
+	Skipped int `json:"skipped" example:"1"`
+
+	// @Description Number of entries that failed validation
+	Invalid int `json:"invalid" example:"1"`
+
+	// @Description Per-entry results, in file order
+	Rows []ImportRowResult `json:"rows"`
+}
+
+// Add records result in the report and updates its counters
+func (r *ImportReport) Add(result ImportRowResult) {
+	r.Total++
+	switch result.Status {
+	case ImportStatusCreated:
+		r.Created++
+	case ImportStatusUpdated:
+		r.Updated++
+	case ImportStatusSkipped:
+		r.Skipped++
+	case ImportStatusInvalid:
+		r.Invalid++
+	}
+	r.Rows = append(r.Rows, result)
+}
@@ -0,0 +1,152 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Interaction types
+const (
+	InteractionCalled    = "called"
+	InteractionMet       = "met"
+	InteractionSentCard  = "sent_card"
+	InteractionGiftGiven = "gift_given"
+	InteractionOther     = "other"
+)
+
+// InteractionTypes lists the interaction types
+var InteractionTypes = []string{InteractionCalled, InteractionMet, InteractionSentCard, InteractionGiftGiven, InteractionOther}
+
+// CreateInteractionRequest represents the request for creating or updating a journal entry
+// @Description Request model for creating or updating an interaction journal entry
+type CreateInteractionRequest struct {
+	// @Description Kind of interaction
+	Type string `json:"type" binding:"required" enums:"called,met,sent_card,gift_given,other" example:"called"`
+
+	// @Description When the interaction happened; defaults to now for new entries and is kept when omitted on updates
+	OccurredAt *time.Time `json:"occurred_at,omitempty" example:"2025-05-15T18:30:00Z"`
+
+	// @Description Free text about the interaction
+	Text string `json:"text,omitempty" example:"Called to wish happy birthday, talked about the new job"`
+
+	// @Description Optional gift of the same birthday the interaction is about
+	GiftID *uuid.UUID `json:"gift_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440013"`
+}
+
+// Interaction is a dated journal entry about contact with the person of a
+// birthday
+// @Description Interaction model
+type Interaction struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440015"`
+	BirthdayID uuid.UUID  `gorm:"type:uuid;not null;index" json:"birthday_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Birthday   Birthday   `gorm:"foreignKey:BirthdayID;constraint:OnDelete:CASCADE" json:"-"`
+	Type       string     `gorm:"size:20;not null" json:"type" example:"called"`
+	OccurredAt time.Time  `gorm:"not null;index" json:"occurred_at"`
+	Text       string     `gorm:"type:text" json:"text" example:"Called to wish happy birthday, talked about the new job"`
+	GiftID     *uuid.UUID `gorm:"type:uuid;index" json:"gift_id" example:"550e8400-e29b-41d4-a716-446655440013"`
+	Gift       *Gift      `gorm:"foreignKey:GiftID;constraint:OnDelete:SET NULL" json:"-"`
+	CreatedAt  time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt  time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// InteractionResponse represents the response for journal entry operations
+// @Description Response model for interaction journal entries
+type InteractionResponse struct {
+	// @Description Unique identifier for the entry
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440015"`
+
+	// @Description Birthday the entry belongs to
+	BirthdayID uuid.UUID `json:"birthday_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// @Description Kind of interaction
+	Type string `json:"type" enums:"called,met,sent_card,gift_given,other" example:"called"`
+
+	// @Description When the interaction happened
+	OccurredAt time.Time `json:"occurred_at"`
+
+	// @Description Free text about the interaction
+	Text string `json:"text" example:"Called to wish happy birthday, talked about the new job"`
+
+	// @Description Gift the interaction is about, if any
+	GiftID *uuid.UUID `json:"gift_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440013"`
+
+	// @Description When the entry was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// @Description When the entry was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToResponse converts Interaction model to InteractionResponse
+func (i *Interaction) ToResponse() *InteractionResponse {
+	return &InteractionResponse{
+		ID:         i.ID,
+		BirthdayID: i.BirthdayID,
+		Type:       i.Type,
+		OccurredAt: i.OccurredAt,
+		Text:       i.Text,
+		GiftID:     i.GiftID,
+		CreatedAt:  i.CreatedAt,
+		UpdatedAt:  i.UpdatedAt,
+	}
+}
+
+// Timeline entry sources
+const (
+	TimelineJournal = "journal"
+	TimelineSystem  = "system"
+)
+
+// TimelineEntry represents one event in the timeline of a birthday
+// @Description Journal entry or system event in the timeline of a birthday
+type TimelineEntry struct {
+	// @Description Where the entry comes from: the interaction journal or the revision history
+	Source string `json:"source" enums:"journal,system" example:"journal"`
+
+	// @Description ID of the journal entry or revision
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440015"`
+
+	// @Description Interaction type for journal entries, revision action (created, updated, deleted, restored, reverted, merged) for system events
+	Type string `json:"type" example:"called"`
+
+	// @Description When it happened
+	OccurredAt time.Time `json:"occurred_at"`
+
+	// @Description Free text of journal entries
+	Text string `json:"text,omitempty" example:"Called to wish happy birthday, talked about the new job"`
+
+	// @Description Gift a journal entry is about, if any
+	GiftID *uuid.UUID `json:"gift_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440013"`
+
+	// @Description User who made the change, for system events
+	ActorID *uuid.UUID `json:"actor_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+
+	// @Description Changed fields with their previous and new values, for system events
+	Changes RevisionChanges `json:"changes,omitempty"`
+}
+
+// TimelineEntry converts the interaction to an entry of the timeline
+func (i *Interaction) TimelineEntry() *TimelineEntry {
+	return &TimelineEntry{
+		Source:     TimelineJournal,
+		ID:         i.ID,
+		Type:       i.Type,
+		OccurredAt: i.OccurredAt,
+		Text:       i.Text,
+		GiftID:     i.GiftID,
+	}
+}
+
+// TimelineEntry converts the revision to a system event of the timeline
+func (r *BirthdayRevision) TimelineEntry() *TimelineEntry {
+	actorID := r.ActorID
+	return &TimelineEntry{
+		Source:     TimelineSystem,
+		ID:         r.ID,
+		Type:       r.Action,
+		OccurredAt: r.CreatedAt,
+		ActorID:    &actorID,
+		Changes:    r.Changes,
+	}
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BirthdayPhoto describes the photo attached to a birthday. The image and its
+// thumbnail are kept in object storage under Key and ThumbnailKey. Replaced
+// photos, and those of purged birthdays, are detached (BirthdayID is null)
+// until their objects have been deleted.
+// @Description Birthday photo model
+type BirthdayPhoto struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440009"`
+	BirthdayID   *uuid.UUID `gorm:"type:uuid;uniqueIndex" json:"birthday_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Key          string     `gorm:"size:255;not null" json:"-"`
+	ThumbnailKey string     `gorm:"size:255;not null" json:"-"`
+	ContentType  string     `gorm:"size:50;not null" json:"content_type" example:"image/jpeg"`
+	Size         int64      `gorm:"not null" json:"size" example:"284133"`
+	Width        int        `gorm:"not null" json:"width" example:"1536"`
+	Height       int        `gorm:"not null" json:"height" example:"2048"`
+	CreatedAt    time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// BirthdayPhotoResponse represents the response for photo operations
+// @Description Response model for a birthday photo
+type BirthdayPhotoResponse struct {
+	// @Description Path to download the photo
+	URL string `json:"url" example:"/api/v1/birthdays/550e8400-e29b-41d4-a716-446655440000/photo"`
+
+	// @Description Path to download the 256x256 thumbnail
+	ThumbnailURL string `json:"thumbnail_url" example:"/api/v1/birthdays/550e8400-e29b-41d4-a716-446655440000/photo/thumbnail"`
+
+	// @Description Content type of the stored photo
+	ContentType string `json:"content_type" example:"image/jpeg"`
+
+	// @Description Size of the stored photo in bytes
+	Size int64 `json:"size" example:"284133"`
+
+	// @Description Width of the stored photo in pixels
+	Width int `json:"width" example:"1536"`
+
+	// @Description Height of the stored photo in pixels
+	Height int `json:"height" example:"2048"`
+
+	// @Description When the photo was uploaded
+	UploadedAt time.Time `json:"uploaded_at"`
+}
+
+// ToResponse converts an attached BirthdayPhoto model to BirthdayPhotoResponse
+func (p *BirthdayPhoto) ToResponse() *BirthdayPhotoResponse {
+	url := fmt.Sprintf("/api/v1/birthdays/%s/photo", *p.BirthdayID)
+	return &BirthdayPhotoResponse{
+		URL:          url,
+		ThumbnailURL: url + "/thumbnail",
+		ContentType:  p.ContentType,
+		Size:         p.Size,
+		Width:        p.Width,
+		Height:       p.Height,
+		UploadedAt:   p.CreatedAt,
+	}
+}
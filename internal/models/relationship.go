@@ -0,0 +1,110 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Relationship types. A type names the role the related person has for the
+// birthday: a relationship of type child from John to Anna means Anna is
+// John's child.
+const (
+	RelationshipParent      = "parent"
+	RelationshipChild       = "child"
+	RelationshipSibling     = "sibling"
+	RelationshipSpouse      = "spouse"
+	RelationshipPartner     = "partner"
+	RelationshipGrandparent = "grandparent"
+	RelationshipGrandchild  = "grandchild"
+	RelationshipAuntUncle   = "aunt_uncle"
+	RelationshipNieceNephew = "niece_nephew"
+	RelationshipCousin      = "cousin"
+	RelationshipInLaw       = "in_law"
+	RelationshipFriend      = "friend"
+	RelationshipOther       = "other"
+)
+
+// relationshipInverses maps each relationship type to the type of the
+// reverse direction
+var relationshipInverses = map[string]string{
+	RelationshipParent:      RelationshipChild,
+	RelationshipChild:       RelationshipParent,
+	RelationshipSibling:     RelationshipSibling,
+	RelationshipSpouse:      RelationshipSpouse,
+	RelationshipPartner:     RelationshipPartner,
+	RelationshipGrandparent: RelationshipGrandchild,
+	RelationshipGrandchild:  RelationshipGrandparent,
+	RelationshipAuntUncle:   RelationshipNieceNephew,
+	RelationshipNieceNephew: RelationshipAuntUncle,
+	RelationshipCousin:      RelationshipCousin,
+	RelationshipInLaw:       RelationshipInLaw,
+	RelationshipFriend:      RelationshipFriend,
+	RelationshipOther:       RelationshipOther,
+}
+
+// InverseRelationship returns the type of the reverse direction of a
+// relationship of type t, and whether t is a known type
+func InverseRelationship(t string) (string, bool) {
+	inverse, ok := relationshipInverses[t]
+	return inverse, ok
+}
+
+// CreateRelationshipRequest represents the request for relating two birthdays
+// @Description Request model for adding a relationship to a birthday
+type CreateRelationshipRequest struct {
+	// @Description ID of the related birthday
+	RelatedID uuid.UUID `json:"related_id" binding:"required" example:"550e8400-e29b-41d4-a716-446655440010"`
+
+	// @Description Role of the related person, e.g. child when the related person is this person's child
+	Type string `json:"type" binding:"required" enums:"parent,child,sibling,spouse,partner,grandparent,grandchild,aunt_uncle,niece_nephew,cousin,in_law,friend,other" example:"child"`
+}
+
+// BirthdayRelationship is one direction of a relationship between two
+// birthdays. Every relationship is stored in both directions, the reverse
+// one with the inverse type.
+// @Description Birthday relationship model
+type BirthdayRelationship struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440011"`
+	BirthdayID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_birthday_relationships_pair" json:"birthday_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Birthday   Birthday  `gorm:"foreignKey:BirthdayID;constraint:OnDelete:CASCADE" json:"-"`
+	RelatedID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_birthday_relationships_pair;index" json:"related_id" example:"550e8400-e29b-41d4-a716-446655440010"`
+	Related    Birthday  `gorm:"foreignKey:RelatedID;constraint:OnDelete:CASCADE" json:"-"`
+	Type       string    `gorm:"size:20;not null" json:"type" example:"child"`
+	CreatedAt  time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// RelationshipResponse represents the response for relationship operations
+// @Description Response model for a relative of a birthday
+type RelationshipResponse struct {
+	// @Description Unique identifier for this direction of the relationship
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440011"`
+
+	// @Description Role of the related person
+	Type string `json:"type" enums:"parent,child,sibling,spouse,partner,grandparent,grandchild,aunt_uncle,niece_nephew,cousin,in_law,friend,other" example:"child"`
+
+	// @Description ID of the related birthday
+	RelatedID uuid.UUID `json:"related_id" example:"550e8400-e29b-41d4-a716-446655440010"`
+
+	// @Description Name of the related person
+	RelatedName string `json:"related_name" example:"Anna Doe"`
+
+	// @Description Birth date of the related person (format: YYYY-MM-DD or MM-DD)
+	RelatedBirthDate string `json:"related_birth_date" example:"2015-03-02"`
+
+	// @Description When the relationship was added
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ToResponse converts a BirthdayRelationship with its related birthday loaded
+// to RelationshipResponse
+func (r *BirthdayRelationship) ToResponse() *RelationshipResponse {
+	return &RelationshipResponse{
+		ID:               r.ID,
+		Type:             r.Type,
+		RelatedID:        r.RelatedID,
+		RelatedName:      r.Related.Name,
+		RelatedBirthDate: r.Related.FormatBirthDate(),
+		CreatedAt:        r.CreatedAt,
+	}
+}
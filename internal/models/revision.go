@@ -0,0 +1,269 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Revision actions
+const (
+	RevisionCreated  = "created"
+	RevisionUpdated  = "updated"
+	RevisionDeleted  = "deleted"
+	RevisionRestored = "restored"
+	RevisionReverted = "reverted"
+	RevisionMerged   = "merged"
+)
+
+// BirthdayRevision records one change of a birthday
+// @Description Birthday revision model
+type BirthdayRevision struct {
+	ID         uuid.UUID        `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440007"`
+	BirthdayID uuid.UUID        `gorm:"type:uuid;not null;index" json:"birthday_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Birthday   Birthday         `gorm:"foreignKey:BirthdayID;constraint:OnDelete:CASCADE" json:"-"`
+	ActorID    uuid.UUID        `gorm:"type:uuid;not null" json:"actor_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Action     string           `gorm:"size:10;not null" json:"action" example:"updated"`
+	Changes    RevisionChanges  `gorm:"type:jsonb;not null" json:"changes"`
+	Snapshot   BirthdaySnapshot `gorm:"type:jsonb;not null" json:"snapshot"`
+	RevertedTo *uuid.UUID       `gorm:"type:uuid" json:"reverted_to,omitempty"`
+	CreatedAt  time.Time        `gorm:"default:CURRENT_TIMESTAMP;index" json:"created_at"`
+}
+
+// BirthdayRevisionResponse represents the response for revision operations
+// @Description Response model for a birthday revision
+type BirthdayRevisionResponse struct {
+	// @Description Unique identifier for the revision
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440007"`
+
+	// @Description Birthday the revision belongs to
+	BirthdayID uuid.UUID `json:"birthday_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// @Description User who made the change
+	ActorID uuid.UUID `json:"actor_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+
+	// @Description What happened to the birthday
+	Action string `json:"action" enums:"created,updated,deleted,restored,reverted,merged" example:"updated"`
+
+	// @Description Changed fields with their previous and new values
+	Changes RevisionChanges `json:"changes"`
+
+	// @Description The birthday as it was after the change
+	Snapshot BirthdaySnapshot `json:"snapshot"`
+
+	// @Description Revision whose version was restored, for reverted revisions
+	RevertedTo *uuid.UUID `json:"reverted_to,omitempty" example:"550e8400-e29b-41d4-a716-446655440008"`
+
+	// @Description When the change was made
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ToResponse converts a BirthdayRevision to BirthdayRevisionResponse
+func (r *BirthdayRevision) ToResponse() *BirthdayRevisionResponse {
+	return &BirthdayRevisionResponse{
+		ID:         r.ID,
+		BirthdayID: r.BirthdayID,
+		ActorID:    r.ActorID,
+		Action:     r.Action,
+		Changes:    r.Changes,
+		Snapshot:   r.Snapshot,
+		RevertedTo: r.RevertedTo,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+// BirthdaySnapshot holds the user-editable fields of a birthday
+// @Description State of a birthday at one revision
+type BirthdaySnapshot struct {
+	// @Description Name of the person
+	Name string `json:"name" example:"John Doe"`
+
+	// @Description Birthday date (format: YYYY-MM-DD or MM-DD)
+	BirthDate string `json:"birth_date" example:"1990-05-15"`
+
+	// @Description Kind of event; empty in revisions recorded before event types existed
+	EventType string `json:"event_type,omitempty" example:"birthday"`
+
+	// @Description Second person of an anniversary
+	PartnerName string `json:"partner_name,omitempty" example:"Jane Doe"`
+
+	// @Description Category name
+	Category string `json:"category" example:"Family"`
+
+	// @Description Notes
+	Notes string `json:"notes" example:"Best friend from college"`
+
+	// @Description Tags, sorted by name
+	Tags []string `json:"tags" example:"college,vip"`
+
+	// @Description Phone numbers
+	Phones []PhoneNumber `json:"phones"`
+
+	// @Description Email addresses
+	Emails []EmailAddress `json:"emails"`
+
+	// @Description Postal addresses
+	Addresses []PostalAddress `json:"addresses"`
+
+	// @Description Social network handles
+	SocialHandles []SocialHandle `json:"social_handles"`
+}
+
+// Snapshot returns the current state of the birthday's editable fields
+func (b *Birthday) Snapshot() BirthdaySnapshot {
+	tags := b.TagNames()
+	sort.Strings(tags)
+	return BirthdaySnapshot{
+		Name:        b.Name,
+		BirthDate:   b.FormatBirthDate(),
+		EventType:   b.Type().Key,
+		PartnerName: b.PartnerName,
+		Category:    b.Category,
+		Notes:       b.Notes,
+		Tags:        tags,
+
+		Phones:        nonNil(b.Contact.Phones),
+		Emails:        nonNil(b.Contact.Emails),
+		Addresses:     nonNil(b.Contact.Addresses),
+		SocialHandles: nonNil(b.Contact.SocialHandles),
+	}
+}
+
+// ToRequest converts the snapshot to the request that restores it. Tags are
+// always replaced; contact details too, unless the snapshot was recorded
+// before birthdays had them. Snapshots without an event type keep the
+// current one.
+func (s *BirthdaySnapshot) ToRequest() *CreateBirthdayRequest {
+	tags := append([]string{}, s.Tags...)
+	return &CreateBirthdayRequest{
+		Name:        s.Name,
+		BirthDate:   s.BirthDate,
+		EventType:   s.EventType,
+		PartnerName: s.PartnerName,
+		Category:    s.Category,
+		Notes:       s.Notes,
+		Tags:        tags,
+
+		Phones:        slices.Clone(s.Phones),
+		Emails:        slices.Clone(s.Emails),
+		Addresses:     slices.Clone(s.Addresses),
+		SocialHandles: slices.Clone(s.SocialHandles),
+	}
+}
+
+// ChangesFrom returns the fields that differ between before and s. A nil
+// before means the birthday was just created: every field set in s is
+// reported with a null previous value.
+func (s *BirthdaySnapshot) ChangesFrom(before *BirthdaySnapshot) RevisionChanges {
+	if before == nil {
+		changes := s.ChangesFrom(&BirthdaySnapshot{})
+		for field, change := range changes {
+			changes[field] = FieldChange{To: change.To}
+		}
+		changes["event_type"] = FieldChange{To: s.eventType()}
+		return changes
+	}
+
+	changes := RevisionChanges{}
+	fields := []struct {
+		name          string
+		before, after string
+	}{
+		{"name", before.Name, s.Name},
+		{"birth_date", before.BirthDate, s.BirthDate},
+		{"event_type", before.eventType(), s.eventType()},
+		{"partner_name", before.PartnerName, s.PartnerName},
+		{"category", before.Category, s.Category},
+		{"notes", before.Notes, s.Notes},
+	}
+	for _, field := range fields {
+		if field.before != field.after {
+			changes[field.name] = FieldChange{From: field.before, To: field.after}
+		}
+	}
+
+	diffList(changes, "tags", before.Tags, s.Tags)
+	diffList(changes, "phones", before.Phones, s.Phones)
+	diffList(changes, "emails", before.Emails, s.Emails)
+	diffList(changes, "addresses", before.Addresses, s.Addresses)
+	diffList(changes, "social_handles", before.SocialHandles, s.SocialHandles)
+
+	return changes
+}
+
+// eventType returns the event type of the snapshot. Snapshots taken before
+// event types existed are of birthdays.
+func (s *BirthdaySnapshot) eventType() string {
+	if s.EventType == "" {
+		return EventTypeBirthday
+	}
+	return s.EventType
+}
+
+// diffList records a change of the list field name in changes, if any
+func diffList[T comparable](changes RevisionChanges, name string, before, after []T) {
+	if !slices.Equal(before, after) {
+		changes[name] = FieldChange{From: nonNil(before), To: nonNil(after)}
+	}
+}
+
+// nonNil returns values, or an empty slice instead of nil so that it is
+// encoded as an empty JSON array
+func nonNil[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
+
+// Value stores the snapshot as JSON
+func (s BirthdaySnapshot) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// Scan reads a snapshot stored as JSON
+func (s *BirthdaySnapshot) Scan(value interface{}) error {
+	return scanJSON(value, s)
+}
+
+// FieldChange holds the value of a field before and after a revision
+// @Description Previous and new value of a field
+type FieldChange struct {
+	// @Description Value before the change
+	From interface{} `json:"from"`
+
+	// @Description Value after the change
+	To interface{} `json:"to"`
+}
+
+// RevisionChanges maps field names to their change
+type RevisionChanges map[string]FieldChange
+
+// Value stores the changes as JSON
+func (c RevisionChanges) Value() (driver.Value, error) {
+	if c == nil {
+		c = RevisionChanges{}
+	}
+	return json.Marshal(c)
+}
+
+// Scan reads changes stored as JSON
+func (c *RevisionChanges) Scan(value interface{}) error {
+	return scanJSON(value, c)
+}
+
+func scanJSON(value interface{}, dest interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, dest)
+	case string:
+		return json.Unmarshal([]byte(v), dest)
+	default:
+		return fmt.Errorf("cannot scan %T as JSON", value)
+	}
+}
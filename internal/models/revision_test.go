@@ -0,0 +1,64 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChangesFrom(t *testing.T) {
+	after := &BirthdaySnapshot{Name: "Jane Doe", BirthDate: "1990-05-15", EventType: EventTypeBirthday, Category: "Friends"}
+
+	tests := []struct {
+		name   string
+		before *BirthdaySnapshot
+		after  *BirthdaySnapshot
+		want   RevisionChanges
+	}{
+		{
+			name:   "created",
+			before: nil,
+			after:  after,
+			want: RevisionChanges{
+				"name":       {To: "Jane Doe"},
+				"birth_date": {To: "1990-05-15"},
+				"event_type": {To: EventTypeBirthday},
+				"category":   {To: "Friends"},
+			},
+		},
+		{
+			name:   "unchanged",
+			before: &BirthdaySnapshot{Name: "Jane Doe", BirthDate: "1990-05-15", EventType: EventTypeBirthday, Category: "Friends"},
+			after:  after,
+			want:   RevisionChanges{},
+		},
+		{
+			name:   "snapshot without event type is a birthday",
+			before: &BirthdaySnapshot{Name: "Jane Doe", BirthDate: "1990-05-15", Category: "Friends"},
+			after:  after,
+			want:   RevisionChanges{},
+		},
+		{
+			name:   "event type changed from a snapshot without one",
+			before: &BirthdaySnapshot{Name: "Jane Doe", BirthDate: "1990-05-15", Category: "Friends"},
+			after:  &BirthdaySnapshot{Name: "Jane Doe", BirthDate: "1990-05-15", EventType: EventTypeMemorial, Category: "Friends"},
+			want:   RevisionChanges{"event_type": {From: EventTypeBirthday, To: EventTypeMemorial}},
+		},
+		{
+			name:   "partner name and tags changed",
+			before: &BirthdaySnapshot{Name: "Jane", EventType: EventTypeAnniversary, Tags: []string{"a"}},
+			after:  &BirthdaySnapshot{Name: "Jane", EventType: EventTypeAnniversary, PartnerName: "John", Tags: []string{"a", "b"}},
+			want: RevisionChanges{
+				"partner_name": {From: "", To: "John"},
+				"tags":         {From: []string{"a"}, To: []string{"a", "b"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.after.ChangesFrom(tt.before); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChangesFrom() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TagBirthdayRequest represents the request for adding tags to a birthday
+// @Description Request model for tagging a birthday
+type TagBirthdayRequest struct {
+	// @Description Tags to add; unknown tags are created. Tags are lower-cased and may not contain commas.
+	Tags []string `json:"tags" binding:"required,min=1,dive,required,max=50" example:"college,vip"`
+}
+
+// Tag represents a user-defined label that can be attached to many birthdays
+// @Description Tag model for labelling birthdays
+type Tag struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440003"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_tags_user_name" json:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	User      User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
+	Name      string    `gorm:"size:50;not null;uniqueIndex:idx_tags_user_name" json:"name" example:"college"`
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// TagResponse represents the response for tag operations
+// @Description Response model for tags
+type TagResponse struct {
+	// @Description Unique identifier for the tag
+	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440003"`
+
+	// @Description Name of the tag
+	Name string `json:"name" example:"college"`
+
+	// @Description Number of birthdays carrying the tag
+	BirthdayCount int64 `json:"birthday_count" example:"4"`
+
+	// @Description When the tag was created
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NormalizeTagName returns the canonical form of a tag: trimmed and lower-cased
+func NormalizeTagName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -11,13 +11,19 @@ import (
 type CreateUserRequest struct {
 	// @Description User's full name
 	Name string `json:"name" binding:"required" example:"John Smith"`
-	
+
 	// @Description User's email address (must be unique)
 	Email string `json:"email" binding:"required,email" example:"john.smith@example.com"`
-	
+
 	// @Description User's password (minimum 6 characters)
 	// @Required
 	Password string `json:"password" binding:"required,min=6" example:"secretpassword123" minLength:"6"`
+
+	// @Description User's IANA timezone, used for upcoming birthday calculations (defaults to UTC)
+	Timezone string `json:"timezone,omitempty" example:"Europe/Istanbul"`
+
+	// @Description When Feb 29 birthdays are observed in non-leap years (defaults to "mar1")
+	LeapDayPolicy LeapDayPolicy `json:"leap_day_policy,omitempty" enums:"feb28,mar1,skip" example:"mar1"`
 }
 
 // UserResponse represents the response after user creation
@@ -25,16 +31,22 @@ type CreateUserRequest struct {
 type UserResponse struct {
 	// @Description Unique identifier for the user
 	ID uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	
+
 	// @Description User's full name
 	Name string `json:"name" example:"John Smith"`
-	
+
 	// @Description User's email address
 	Email string `json:"email" example:"john.smith@example.com"`
-	
+
+	// @Description User's IANA timezone
+	Timezone string `json:"timezone" example:"Europe/Istanbul"`
+
+	// @Description When Feb 29 birthdays are observed in non-leap years ("feb28", "mar1" or "skip")
+	LeapDayPolicy LeapDayPolicy `json:"leap_day_policy" example:"mar1"`
+
 	// @Description When the user was created
 	CreatedAt time.Time `json:"created_at" example:"2024-01-01T00:00:00Z"`
-	
+
 	// @Description When the user was last updated
 	UpdatedAt time.Time `json:"updated_at" example:"2024-01-01T00:00:00Z"`
 }
@@ -42,23 +54,90 @@ type UserResponse struct {
 // User represents a user in the system
 // @Description User model
 type User struct {
-	ID           uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Name         string     `gorm:"size:100;not null" json:"name" example:"John Smith"`
-	Email        string     `gorm:"size:255;not null;unique" json:"email" example:"john.smith@example.com"`
-	PasswordHash string     `gorm:"size:255;not null" json:"-"`
-	CreatedAt    time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at" example:"2024-01-01T00:00:00Z"`
-	UpdatedAt    time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at" example:"2024-01-01T00:00:00Z"`
-	Birthdays    []Birthday `gorm:"foreignKey:UserID" json:"-"` // Using json:"-" to exclude from Swagger docs
+	ID            uuid.UUID     `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name          string        `gorm:"size:100;not null" json:"name" example:"John Smith"`
+	Email         string        `gorm:"size:255;not null;unique" json:"email" example:"john.smith@example.com"`
+	PasswordHash  string        `gorm:"size:255;not null" json:"-"`
+	Timezone      string        `gorm:"size:64;not null;default:'UTC'" json:"timezone" example:"Europe/Istanbul"`
+	LeapDayPolicy LeapDayPolicy `gorm:"size:10;not null;default:'mar1'" json:"leap_day_policy" example:"mar1"`
+	CreatedAt     time.Time     `gorm:"default:CURRENT_TIMESTAMP" json:"created_at" example:"2024-01-01T00:00:00Z"`
+	UpdatedAt     time.Time     `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at" example:"2024-01-01T00:00:00Z"`
+	Birthdays     []Birthday    `gorm:"foreignKey:UserID" json:"-"` // Using json:"-" to exclude from Swagger docs
 }
 
 // ToResponse converts User model to UserResponse
 func (u *User) ToResponse() *UserResponse {
 	return &UserResponse{
-		ID:        u.ID,
-		Name:      u.Name,
-		Email:     u.Email,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+		ID:            u.ID,
+		Name:          u.Name,
+		Email:         u.Email,
+		Timezone:      u.Timezone,
+		LeapDayPolicy: u.LeapDayPolicy,
+		CreatedAt:     u.CreatedAt,
+		UpdatedAt:     u.UpdatedAt,
+	}
+}
+
+// Location returns the user's configured timezone, falling back to UTC
+// when the stored value is empty or unknown.
+func (u *User) Location() *time.Location {
+	if u.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Today returns the user's current calendar date as midnight UTC, so that
+// date arithmetic is not affected by DST transitions in the user's timezone.
+func (u *User) Today(now time.Time) time.Time {
+	local := now.In(u.Location())
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Calendar returns the user's date settings as of now.
+func (u *User) Calendar(now time.Time) Calendar {
+	policy := u.LeapDayPolicy
+	if !policy.IsValid() {
+		policy = LeapDayMar1
+	}
+	return Calendar{Today: u.Today(now), LeapDayPolicy: policy}
+}
+
+// LeapDayPolicy decides when a Feb 29 birthday is observed in non-leap years
+type LeapDayPolicy string
+
+const (
+	LeapDayFeb28 LeapDayPolicy = "feb28"
+	LeapDayMar1  LeapDayPolicy = "mar1"
+	LeapDaySkip  LeapDayPolicy = "skip"
+)
+
+// IsValid reports whether p is one of the known leap-day policies
+func (p LeapDayPolicy) IsValid() bool {
+	switch p {
+	case LeapDayFeb28, LeapDayMar1, LeapDaySkip:
+		return true
+	}
+	return false
+}
+
+// Calendar carries the per-user context needed to place birthdays on real
+// dates: the user's local date (as midnight UTC) and their leap-day policy.
+type Calendar struct {
+	Today         time.Time
+	LeapDayPolicy LeapDayPolicy
+}
+
+// DefaultCalendar returns a UTC calendar with the default leap-day policy.
+func DefaultCalendar(now time.Time) Calendar {
+	now = now.UTC()
+	return Calendar{
+		Today:         time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
+		LeapDayPolicy: LeapDayMar1,
 	}
 }
 
@@ -67,7 +146,7 @@ func (u *User) ToResponse() *UserResponse {
 type LoginRequest struct {
 	// @Description User's email address
 	Email string `json:"email" binding:"required,email" example:"john.smith@example.com"`
-	
+
 	// @Description User's password
 	Password string `json:"password" binding:"required" example:"secretpassword123"`
 }
@@ -77,7 +156,7 @@ type LoginRequest struct {
 type LoginResponse struct {
 	// @Description JWT access token
 	Token string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
-	
+
 	// @Description Basic user information
 	User UserResponse `json:"user"`
 }
@@ -87,10 +166,16 @@ type LoginResponse struct {
 type UpdateUserRequest struct {
 	// @Description User's full name
 	Name string `json:"name" binding:"required" example:"John Smith"`
-	
+
 	// @Description User's email address (must be unique)
 	Email string `json:"email" binding:"required,email" example:"john.smith@example.com"`
-	
+
 	// @Description User's new password (optional, minimum 6 characters if provided)
 	Password string `json:"password" binding:"omitempty,min=6" example:"newpassword123" minLength:"6" swaggertype:"string"`
-} 
\ No newline at end of file
+
+	// @Description User's IANA timezone (optional, left unchanged if empty)
+	Timezone string `json:"timezone,omitempty" example:"Europe/Istanbul"`
+
+	// @Description When Feb 29 birthdays are observed in non-leap years (optional, left unchanged if empty)
+	LeapDayPolicy LeapDayPolicy `json:"leap_day_policy,omitempty" enums:"feb28,mar1,skip" example:"mar1"`
+}
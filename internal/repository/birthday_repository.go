@@ -1,11 +1,50 @@
 package repository
 
 import (
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/murathanje/birthday_tracking_backend/internal/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Sort orders supported by BirthdayRepository.List
+const (
+	SortNextOccurrence = "next"
+	SortName           = "name"
+	SortNameDesc       = "-name"
+	SortCreatedAt      = "created_at"
+	SortCreatedAtDesc  = "-created_at"
 )
 
+// BirthdayFilter selects a page of a user's birthdays for BirthdayRepository.List.
+// An empty EventType matches events of every type.
+type BirthdayFilter struct {
+	UserID     uuid.UUID
+	EventType  string
+	Category   string
+	Month      int
+	NamePrefix string
+	Search     string
+	Tags       []string
+	MatchAll   bool
+	Sort       string
+	Calendar   models.Calendar
+	After      *BirthdayCursor
+	Limit      int
+}
+
+// BirthdayCursor holds the sort key of the last row of a page. Only the
+// fields relevant to the filter's sort order are used.
+type BirthdayCursor struct {
+	Key       int       `json:"k,omitempty"`
+	Name      string    `json:"n,omitempty"`
+	CreatedAt time.Time `json:"c,omitempty"`
+	ID        uuid.UUID `json:"i"`
+}
+
 type BirthdayRepository struct {
 	db *gorm.DB
 }
@@ -14,6 +53,18 @@ func NewBirthdayRepository(db *gorm.DB) *BirthdayRepository {
 	return &BirthdayRepository{db: db}
 }
 
+// Transaction runs fn in a database transaction. Calling Transaction on a
+// repository bound to that transaction (see WithTx) creates a savepoint, so a
+// failing nested call only rolls back its own changes.
+func (r *BirthdayRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
+
+// WithTx returns a repository that runs its queries in tx
+func (r *BirthdayRepository) WithTx(tx *gorm.DB) *BirthdayRepository {
+	return &BirthdayRepository{db: tx}
+}
+
 func (r *BirthdayRepository) Create(birthday *models.Birthday) error {
 	return r.db.Create(birthday).Error
 }
@@ -26,7 +77,7 @@ func (r *BirthdayRepository) GetAll() ([]models.Birthday, error) {
 
 func (r *BirthdayRepository) GetByID(id uuid.UUID) (*models.Birthday, error) {
 	var birthday models.Birthday
-	err := r.db.First(&birthday, "id = ?", id).Error
+	err := r.db.Scopes(preload).First(&birthday, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -35,20 +86,319 @@ func (r *BirthdayRepository) GetByID(id uuid.UUID) (*models.Birthday, error) {
 
 func (r *BirthdayRepository) GetByUserID(userID uuid.UUID) ([]models.Birthday, error) {
 	var birthdays []models.Birthday
-	err := r.db.Where("user_id = ?", userID).Find(&birthdays).Error
+	err := r.db.Scopes(preload).Where("user_id = ?", userID).Find(&birthdays).Error
+	return birthdays, err
+}
+
+// GetByUserIDAndType returns the user's events of eventType, or of every
+// type when eventType is empty.
+func (r *BirthdayRepository) GetByUserIDAndType(userID uuid.UUID, eventType string) ([]models.Birthday, error) {
+	var birthdays []models.Birthday
+	err := r.db.Scopes(preload, ofType(eventType)).Where("user_id = ?", userID).Find(&birthdays).Error
+	return birthdays, err
+}
+
+// List returns up to filter.Limit birthdays matching filter, ordered by
+// filter.Sort and starting after filter.After.
+func (r *BirthdayRepository) List(filter BirthdayFilter) ([]models.Birthday, error) {
+	query := r.db.Scopes(ofType(filter.EventType)).Where("user_id = ?", filter.UserID)
+
+	if filter.Category != "" {
+		query = query.Where("LOWER(category) = LOWER(?)", filter.Category)
+	}
+	if filter.Month != 0 {
+		query = query.Where("birth_month = ?", filter.Month)
+	}
+	if filter.NamePrefix != "" {
+		query = query.Where("name ILIKE ?", escapeLike(filter.NamePrefix)+"%")
+	}
+	if filter.Search != "" {
+		query = query.Where(searchCondition(filter.Search))
+	}
+	if len(filter.Tags) > 0 {
+		tagged := r.db.Table("birthday_tags").
+			Select("birthday_tags.birthday_id").
+			Joins("JOIN tags ON tags.id = birthday_tags.tag_id").
+			Where("tags.user_id = ? AND tags.name IN ?", filter.UserID, filter.Tags)
+		if filter.MatchAll {
+			tagged = tagged.Group("birthday_tags.birthday_id").
+				Having("COUNT(DISTINCT tags.id) = ?", len(filter.Tags))
+		}
+		query = query.Where("id IN (?)", tagged)
+	}
+
+	switch filter.Sort {
+	case SortName, SortNameDesc:
+		op, dir := keysetDirection(filter.Sort == SortNameDesc)
+		if filter.After != nil {
+			query = query.Where("(name, id) "+op+" (?, ?)", filter.After.Name, filter.After.ID)
+		}
+		query = query.Order("name " + dir).Order("id " + dir)
+	case SortCreatedAt, SortCreatedAtDesc:
+		op, dir := keysetDirection(filter.Sort == SortCreatedAtDesc)
+		if filter.After != nil {
+			query = query.Where("(created_at, id) "+op+" (?, ?)", filter.After.CreatedAt, filter.After.ID)
+		}
+		query = query.Order("created_at " + dir).Order("id " + dir)
+	default:
+		key, args := occurrenceKey(filter.Calendar)
+		query = query.Select("*, "+key+" AS occurrence_key", args...)
+		if filter.After != nil {
+			query = query.Where("("+key+", name, id) > (?, ?, ?)", append(args, filter.After.Key, filter.After.Name, filter.After.ID)...)
+		}
+		query = query.Order("occurrence_key").Order("name").Order("id")
+	}
+
+	var birthdays []models.Birthday
+	err := query.Scopes(preload).Limit(filter.Limit).Find(&birthdays).Error
 	return birthdays, err
 }
 
+// OccurrenceKey returns the sort key used by SortNextOccurrence for birthday:
+// MMDD of its next observance, plus 10000 when that falls next year and 20000
+// when it is further away (Feb 29 under the skip policy).
+func OccurrenceKey(birthday *models.Birthday, cal models.Calendar) int {
+	next := birthday.NextOccurrence(cal)
+	key := int(next.Month())*100 + next.Day()
+	switch next.Year() - cal.Today.Year() {
+	case 0:
+		return key
+	case 1:
+		return 10000 + key
+	default:
+		return 20000 + birthday.BirthMonth*100 + birthday.BirthDay
+	}
+}
+
+// occurrenceKey renders OccurrenceKey as a SQL expression.
+func occurrenceKey(cal models.Calendar) (string, []interface{}) {
+	feb29 := models.Birthday{BirthMonth: 2, BirthDay: 29}
+	leapKey := func(year int) interface{} {
+		if date, ok := feb29.ObservedDate(year, cal.LeapDayPolicy); ok {
+			return int(date.Month())*100 + date.Day()
+		}
+		return nil
+	}
+
+	todayKey := int(cal.Today.Month())*100 + cal.Today.Day()
+	thisYear := "(CASE WHEN birth_month = 2 AND birth_day = 29 THEN CAST(? AS INTEGER) ELSE birth_month * 100 + birth_day END)"
+	nextYear := "(CASE WHEN birth_month = 2 AND birth_day = 29 THEN CAST(? AS INTEGER) ELSE birth_month * 100 + birth_day END)"
+	expr := "(CASE WHEN " + thisYear + " >= ? THEN " + thisYear +
+		" WHEN " + nextYear + " IS NOT NULL THEN 10000 + " + nextYear +
+		" ELSE 20000 + birth_month * 100 + birth_day END)"
+
+	this, next := leapKey(cal.Today.Year()), leapKey(cal.Today.Year()+1)
+	return expr, []interface{}{this, todayKey, this, next, next}
+}
+
+// preload loads the birthdays' tags, ordered by name, and their photos
+func preload(db *gorm.DB) *gorm.DB {
+	return db.Preload("Tags", orderTags).Preload("Photo")
+}
+
+func orderTags(db *gorm.DB) *gorm.DB {
+	return db.Order("tags.name")
+}
+
+// ofType restricts a query to events of eventType; an empty eventType
+// matches every type
+func ofType(eventType string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if eventType == "" {
+			return db
+		}
+		return db.Where("event_type = ?", eventType)
+	}
+}
+
+// searchCondition matches birthdays whose name, notes or contact details
+// contain search, ignoring case. Searches that look like phone numbers also
+// match numbers with different formatting.
+func searchCondition(search string) clause.Expression {
+	pattern := "%" + escapeLike(strings.ToLower(search)) + "%"
+	conditions := []clause.Expression{
+		clause.Expr{SQL: "name ILIKE ?", Vars: []interface{}{pattern}},
+		clause.Expr{SQL: "notes ILIKE ?", Vars: []interface{}{pattern}},
+		clause.Expr{SQL: "search_text LIKE ?", Vars: []interface{}{pattern}},
+	}
+	if digits := models.PhoneDigits(search); len(digits) >= 3 && strings.Trim(search, "0123456789 +-.()/") == "" {
+		conditions = append(conditions, clause.Expr{SQL: "search_text LIKE ?", Vars: []interface{}{"%" + digits + "%"}})
+	}
+	return clause.Or(conditions...)
+}
+
+func keysetDirection(desc bool) (string, string) {
+	if desc {
+		return "<", "DESC"
+	}
+	return ">", "ASC"
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(value)
+}
+
+// FindByName returns the user's birthdays whose name matches name
+// case-insensitively, oldest first.
+func (r *BirthdayRepository) FindByName(userID uuid.UUID, name string) ([]models.Birthday, error) {
+	var birthdays []models.Birthday
+	err := r.db.Scopes(preload).
+		Where("user_id = ? AND LOWER(name) = LOWER(?)", userID, strings.TrimSpace(name)).
+		Order("created_at").
+		Find(&birthdays).Error
+	return birthdays, err
+}
+
+// GetBySourceUID returns the user's birthday imported from the calendar
+// event with the given UID.
+func (r *BirthdayRepository) GetBySourceUID(userID uuid.UUID, sourceUID string) (*models.Birthday, error) {
+	var birthday models.Birthday
+	err := r.db.Scopes(preload).First(&birthday, "user_id = ? AND source_uid = ?", userID, sourceUID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &birthday, nil
+}
+
+// GetByUserIDAndMonths returns the user's events of eventType (every type
+// when empty) that fall in one of months.
+func (r *BirthdayRepository) GetByUserIDAndMonths(userID uuid.UUID, eventType string, months []int) ([]models.Birthday, error) {
+	var birthdays []models.Birthday
+	err := r.db.Scopes(preload, ofType(eventType)).Where("user_id = ? AND birth_month IN ?", userID, months).Find(&birthdays).Error
+	return birthdays, err
+}
+
+// Update saves birthday and replaces its tags with birthday.Tags.
 func (r *BirthdayRepository) Update(birthday *models.Birthday) error {
-	return r.db.Save(birthday).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Tags", "Photo").Save(birthday).Error; err != nil {
+			return err
+		}
+		return tx.Model(birthday).Association("Tags").Replace(birthday.Tags)
+	})
+}
+
+func (r *BirthdayRepository) AddTags(birthday *models.Birthday, tags []models.Tag) error {
+	return r.db.Model(birthday).Association("Tags").Append(tags)
 }
 
+func (r *BirthdayRepository) RemoveTag(birthday *models.Birthday, tag *models.Tag) error {
+	return r.db.Model(birthday).Association("Tags").Delete(tag)
+}
+
+// Delete moves the birthday to the trash. Trashed birthdays are excluded from
+// every other query until they are restored or purged.
 func (r *BirthdayRepository) Delete(id uuid.UUID) error {
 	return r.db.Delete(&models.Birthday{}, "id = ?", id).Error
 }
 
-func (r *BirthdayRepository) GetByCategory(category string) ([]models.Birthday, error) {
+// GetTrashByUserID returns the user's trashed events of eventType (every
+// type when empty), most recently deleted first.
+func (r *BirthdayRepository) GetTrashByUserID(userID uuid.UUID, eventType string) ([]models.Birthday, error) {
 	var birthdays []models.Birthday
-	err := r.db.Where("category = ?", category).Find(&birthdays).Error
+	err := r.db.Unscoped().Scopes(preload, ofType(eventType)).
+		Where("user_id = ? AND deleted_at IS NOT NULL", userID).
+		Order("deleted_at DESC").
+		Find(&birthdays).Error
 	return birthdays, err
-} 
\ No newline at end of file
+}
+
+// GetTrashedByID returns the birthday with the given ID if it is in the trash
+func (r *BirthdayRepository) GetTrashedByID(id uuid.UUID) (*models.Birthday, error) {
+	var birthday models.Birthday
+	err := r.db.Unscoped().Scopes(preload).First(&birthday, "id = ? AND deleted_at IS NOT NULL", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &birthday, nil
+}
+
+// Restore takes birthday out of the trash, saving its category as well since
+// the one it had may have been deleted in the meantime.
+func (r *BirthdayRepository) Restore(birthday *models.Birthday) error {
+	birthday.DeletedAt = gorm.DeletedAt{}
+	return r.db.Unscoped().Model(birthday).
+		Select("deleted_at", "category_id", "category", "updated_at").
+		Updates(birthday).Error
+}
+
+// PurgeDeletedBefore permanently deletes the birthdays trashed before cutoff
+// and returns how many were deleted.
+func (r *BirthdayRepository) PurgeDeletedBefore(cutoff time.Time) (int64, error) {
+	result := r.db.Unscoped().Delete(&models.Birthday{}, "deleted_at < ?", cutoff)
+	return result.RowsAffected, result.Error
+}
+
+// Merge moves the birthdays sourceIDs to the trash after moving the records
+// that belong to them over to survivorID. Tags are not moved; the caller
+// merges them into the survivor beforehand. A survivor without a photo takes
+// the most recently uploaded photo of the sources, gifts, journal entries and
+// celebrations move to the survivor, budgets are added to the survivor's and
+// relationships move as described at mergeRelationships.
+func (r *BirthdayRepository) Merge(survivorID uuid.UUID, sourceIDs []uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var photos int64
+		if err := tx.Model(&models.BirthdayPhoto{}).Where("birthday_id = ?", survivorID).Count(&photos).Error; err != nil {
+			return err
+		}
+		if photos == 0 {
+			latest := tx.Model(&models.BirthdayPhoto{}).Select("id").
+				Where("birthday_id IN ? AND birthday_id <> ?", sourceIDs, survivorID).
+				Order("created_at DESC").Limit(1)
+			if err := tx.Model(&models.BirthdayPhoto{}).Where("id IN (?)", latest).
+				Update("birthday_id", survivorID).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&models.Gift{}).Where("birthday_id IN ? AND birthday_id <> ?", sourceIDs, survivorID).
+			Update("birthday_id", survivorID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Interaction{}).Where("birthday_id IN ? AND birthday_id <> ?", sourceIDs, survivorID).
+			Update("birthday_id", survivorID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Celebration{}).Where("birthday_id IN ? AND birthday_id <> ?", sourceIDs, survivorID).
+			Update("birthday_id", survivorID).Error; err != nil {
+			return err
+		}
+
+		if err := mergeBudgets(tx, "birthday_id", survivorID, sourceIDs); err != nil {
+			return err
+		}
+
+		if err := mergeRelationships(tx, survivorID, sourceIDs); err != nil {
+			return err
+		}
+
+		return tx.Delete(&models.Birthday{}, "id IN ? AND id <> ?", sourceIDs, survivorID).Error
+	})
+}
+
+// mergeRelationships moves the relationships of the sources to the survivor.
+// Relationships among the merged birthdays are dropped, as are those to
+// people the survivor is already related to; when several sources are
+// related to the same person, the oldest relationship is kept.
+func mergeRelationships(tx *gorm.DB, survivorID uuid.UUID, sourceIDs []uuid.UUID) error {
+	merged := append([]uuid.UUID{survivorID}, sourceIDs...)
+	statements := []struct {
+		sql  string
+		args []interface{}
+	}{
+		{"DELETE FROM birthday_relationships WHERE birthday_id IN ? AND related_id IN ?", []interface{}{merged, merged}},
+		{"DELETE FROM birthday_relationships WHERE birthday_id IN ? AND related_id IN (SELECT related_id FROM birthday_relationships WHERE birthday_id = ?)", []interface{}{sourceIDs, survivorID}},
+		{"DELETE FROM birthday_relationships r WHERE r.birthday_id IN ? AND EXISTS (SELECT 1 FROM birthday_relationships o WHERE o.birthday_id IN ? AND o.related_id = r.related_id AND (o.created_at, o.id) < (r.created_at, r.id))", []interface{}{sourceIDs, sourceIDs}},
+		{"DELETE FROM birthday_relationships r WHERE r.related_id IN ? AND NOT EXISTS (SELECT 1 FROM birthday_relationships f WHERE f.birthday_id = r.related_id AND f.related_id = r.birthday_id)", []interface{}{sourceIDs}},
+		{"UPDATE birthday_relationships SET birthday_id = ? WHERE birthday_id IN ?", []interface{}{survivorID, sourceIDs}},
+		{"UPDATE birthday_relationships SET related_id = ? WHERE related_id IN ?", []interface{}{survivorID, sourceIDs}},
+	}
+	for _, statement := range statements {
+		if err := tx.Exec(statement.sql, statement.args...).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type BudgetRepository struct {
+	db *gorm.DB
+}
+
+func NewBudgetRepository(db *gorm.DB) *BudgetRepository {
+	return &BudgetRepository{db: db}
+}
+
+func (r *BudgetRepository) Create(budget *models.Budget) error {
+	return r.db.Omit("User", "Category", "Birthday").Create(budget).Error
+}
+
+func (r *BudgetRepository) GetByID(id uuid.UUID) (*models.Budget, error) {
+	var budget models.Budget
+	err := r.db.First(&budget, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &budget, nil
+}
+
+// GetByUserID returns the user's budgets, of every year when year is 0,
+// ordered by year, yearly budgets first, then category and then birthday
+// budgets
+func (r *BudgetRepository) GetByUserID(userID uuid.UUID, year int) ([]models.Budget, error) {
+	query := r.db.Where("user_id = ?", userID)
+	if year != 0 {
+		query = query.Where("year = ?", year)
+	}
+	var budgets []models.Budget
+	err := query.
+		Order("year").
+		Order("CASE WHEN category_id IS NOT NULL THEN 1 WHEN birthday_id IS NOT NULL THEN 2 ELSE 0 END").
+		Order("currency").
+		Order("created_at").
+		Find(&budgets).Error
+	return budgets, err
+}
+
+func (r *BudgetRepository) Update(budget *models.Budget) error {
+	return r.db.Omit("User", "Category", "Birthday").Save(budget).Error
+}
+
+func (r *BudgetRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Budget{}, "id = ?", id).Error
+}
+
+// mergeBudgets moves the budgets whose column (category_id or birthday_id)
+// is one of sourceIDs to targetID. Budgets for the same year and currency are
+// added up, including an existing budget of the target.
+func mergeBudgets(tx *gorm.DB, column string, targetID uuid.UUID, sourceIDs []uuid.UUID) error {
+	statements := []struct {
+		sql  string
+		args []interface{}
+	}{
+		{"UPDATE budgets t SET amount = t.amount + s.amount, updated_at = CURRENT_TIMESTAMP " +
+			"FROM (SELECT year, currency, SUM(amount) AS amount FROM budgets WHERE " + column + " IN ? GROUP BY year, currency) s " +
+			"WHERE t." + column + " = ? AND t.year = s.year AND t.currency = s.currency", []interface{}{sourceIDs, targetID}},
+		{"INSERT INTO budgets (user_id, year, " + column + ", amount, currency) " +
+			"SELECT s.user_id, s.year, ?, SUM(s.amount), s.currency FROM budgets s WHERE s." + column + " IN ? " +
+			"AND NOT EXISTS (SELECT 1 FROM budgets t WHERE t." + column + " = ? AND t.year = s.year AND t.currency = s.currency) " +
+			"GROUP BY s.user_id, s.year, s.currency", []interface{}{targetID, sourceIDs, targetID}},
+		{"DELETE FROM budgets WHERE " + column + " IN ?", []interface{}{sourceIDs}},
+	}
+	for _, statement := range statements {
+		if err := tx.Exec(statement.sql, statement.args...).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
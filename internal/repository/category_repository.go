@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CategoryRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) *CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+// WithTx returns a repository that runs its queries in tx
+func (r *CategoryRepository) WithTx(tx *gorm.DB) *CategoryRepository {
+	return &CategoryRepository{db: tx}
+}
+
+func (r *CategoryRepository) Create(category *models.Category) error {
+	return r.db.Create(category).Error
+}
+
+// CreateIfNotExists inserts category unless the user already has one with
+// the same normalized name. It reports whether the row was inserted; the
+// statement never fails on the conflict, so it is safe inside a transaction.
+func (r *CategoryRepository) CreateIfNotExists(category *models.Category) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "normalized_name"}},
+		DoNothing: true,
+	}).Create(category)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *CategoryRepository) GetByID(id uuid.UUID) (*models.Category, error) {
+	var category models.Category
+	err := r.db.First(&category, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (r *CategoryRepository) GetByUserID(userID uuid.UUID) ([]models.Category, error) {
+	var categories []models.Category
+	err := r.db.Where("user_id = ?", userID).Order("sort_order").Order("name").Find(&categories).Error
+	return categories, err
+}
+
+func (r *CategoryRepository) GetByNormalizedName(userID uuid.UUID, normalizedName string) (*models.Category, error) {
+	var category models.Category
+	err := r.db.First(&category, "user_id = ? AND normalized_name = ?", userID, normalizedName).Error
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// Update saves category and copies its name onto every birthday in it,
+// including the ones in the trash, in one transaction.
+func (r *CategoryRepository) Update(category *models.Category) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(category).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Model(&models.Birthday{}).
+			Where("category_id = ?", category.ID).
+			Update("category", category.Name).Error
+	})
+}
+
+func (r *CategoryRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Category{}, "id = ?", id).Error
+}
+
+func (r *CategoryRepository) CountBirthdays(id uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Birthday{}).Where("category_id = ?", id).Count(&count).Error
+	return count, err
+}
+
+// Merge moves every birthday in sourceIDs, including the ones in the trash,
+// to target, adds the sources' budgets to target's and deletes the source
+// categories, in one transaction.
+func (r *CategoryRepository) Merge(target *models.Category, sourceIDs []uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Model(&models.Birthday{}).
+			Where("category_id IN ?", sourceIDs).
+			Updates(map[string]interface{}{"category_id": target.ID, "category": target.Name}).Error
+		if err != nil {
+			return err
+		}
+		if err := mergeBudgets(tx, "category_id", target.ID, sourceIDs); err != nil {
+			return err
+		}
+		return tx.Delete(&models.Category{}, "id IN ? AND user_id = ?", sourceIDs, target.UserID).Error
+	})
+}
+
+// MigrateLegacyCategories creates a category for every distinct free-form
+// category string (compared case-insensitively) on birthdays that are not
+// linked to one yet, and links those birthdays to it. It is idempotent.
+func (r *CategoryRepository) MigrateLegacyCategories() error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`INSERT INTO categories (user_id, name, normalized_name, sort_order)
+			SELECT user_id, MIN(TRIM(category)), LOWER(TRIM(category)), 0
+			FROM birthdays
+			WHERE category_id IS NULL AND TRIM(category) <> ''
+			GROUP BY user_id, LOWER(TRIM(category))
+			ON CONFLICT (user_id, normalized_name) DO NOTHING`).Error
+		if err != nil {
+			return err
+		}
+		return tx.Exec(`UPDATE birthdays SET category_id = categories.id, category = categories.name
+			FROM categories
+			WHERE birthdays.category_id IS NULL
+				AND categories.user_id = birthdays.user_id
+				AND categories.normalized_name = LOWER(TRIM(birthdays.category))`).Error
+	})
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type CelebrationRepository struct {
+	db *gorm.DB
+}
+
+func NewCelebrationRepository(db *gorm.DB) *CelebrationRepository {
+	return &CelebrationRepository{db: db}
+}
+
+// preloadCelebration loads the tasks of celebrations, oldest first, and their
+// guests, ordered by name
+func preloadCelebration(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Tasks", func(db *gorm.DB) *gorm.DB { return db.Order("created_at, id") }).
+		Preload("Guests", func(db *gorm.DB) *gorm.DB { return db.Order("LOWER(name), id") })
+}
+
+func (r *CelebrationRepository) Create(celebration *models.Celebration) error {
+	return r.db.Omit("Birthday", "Tasks", "Guests").Create(celebration).Error
+}
+
+func (r *CelebrationRepository) GetByID(id uuid.UUID) (*models.Celebration, error) {
+	var celebration models.Celebration
+	err := preloadCelebration(r.db).First(&celebration, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &celebration, nil
+}
+
+// GetByBirthdayID returns the celebrations of a birthday, by date
+func (r *CelebrationRepository) GetByBirthdayID(birthdayID uuid.UUID) ([]models.Celebration, error) {
+	var celebrations []models.Celebration
+	err := preloadCelebration(r.db).Where("birthday_id = ?", birthdayID).
+		Order("date, created_at").
+		Find(&celebrations).Error
+	return celebrations, err
+}
+
+// GetByUserID returns the celebrations of the user's birthdays outside the
+// trash, by date: those on or after from (YYYY-MM-DD), or all of them when
+// from is empty.
+func (r *CelebrationRepository) GetByUserID(userID uuid.UUID, from string) ([]models.Celebration, error) {
+	query := preloadCelebration(r.db).Where("birthday_id IN (?)",
+		r.db.Model(&models.Birthday{}).Select("id").Where("user_id = ?", userID))
+	if from != "" {
+		query = query.Where("date >= ?", from)
+	}
+	var celebrations []models.Celebration
+	err := query.Order("date, created_at").Find(&celebrations).Error
+	return celebrations, err
+}
+
+func (r *CelebrationRepository) Update(celebration *models.Celebration) error {
+	return r.db.Omit("Birthday", "Tasks", "Guests").Save(celebration).Error
+}
+
+func (r *CelebrationRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Celebration{}, "id = ?", id).Error
+}
+
+func (r *CelebrationRepository) CreateTask(task *models.CelebrationTask) error {
+	return r.db.Create(task).Error
+}
+
+func (r *CelebrationRepository) GetTaskByID(id uuid.UUID) (*models.CelebrationTask, error) {
+	var task models.CelebrationTask
+	err := r.db.First(&task, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func (r *CelebrationRepository) UpdateTask(task *models.CelebrationTask) error {
+	return r.db.Save(task).Error
+}
+
+func (r *CelebrationRepository) DeleteTask(id uuid.UUID) error {
+	return r.db.Delete(&models.CelebrationTask{}, "id = ?", id).Error
+}
+
+func (r *CelebrationRepository) CreateGuest(guest *models.CelebrationGuest) error {
+	return r.db.Create(guest).Error
+}
+
+func (r *CelebrationRepository) GetGuestByID(id uuid.UUID) (*models.CelebrationGuest, error) {
+	var guest models.CelebrationGuest
+	err := r.db.First(&guest, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
+func (r *CelebrationRepository) UpdateGuest(guest *models.CelebrationGuest) error {
+	return r.db.Save(guest).Error
+}
+
+func (r *CelebrationRepository) DeleteGuest(id uuid.UUID) error {
+	return r.db.Delete(&models.CelebrationGuest{}, "id = ?", id).Error
+}
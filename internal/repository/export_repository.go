@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ExportRepository struct {
+	db *gorm.DB
+}
+
+func NewExportRepository(db *gorm.DB) *ExportRepository {
+	return &ExportRepository{db: db}
+}
+
+// CreateIfNoneInProgress inserts export unless the user already has an
+// export in progress, which the partial unique index on user_id rules out.
+// It reports whether the row was inserted.
+func (r *ExportRepository) CreateIfNoneInProgress(export *models.DataExport) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(export)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *ExportRepository) GetByID(id uuid.UUID) (*models.DataExport, error) {
+	var export models.DataExport
+	err := r.db.First(&export, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+// GetByUserID returns the user's exports, newest first
+func (r *ExportRepository) GetByUserID(userID uuid.UUID) ([]models.DataExport, error) {
+	var exports []models.DataExport
+	err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&exports).Error
+	return exports, err
+}
+
+func (r *ExportRepository) Update(export *models.DataExport) error {
+	return r.db.Omit("User").Save(export).Error
+}
+
+// GetExpired returns ready exports whose archives expired before now
+func (r *ExportRepository) GetExpired(now time.Time) ([]models.DataExport, error) {
+	var exports []models.DataExport
+	err := r.db.Where("status = ? AND expires_at < ?", models.ExportStatusReady, now).Find(&exports).Error
+	return exports, err
+}
+
+// FailInProgress marks every export that is still being built as failed.
+// It is used at startup, when no export can be running.
+func (r *ExportRepository) FailInProgress(reason string) error {
+	return r.db.Model(&models.DataExport{}).
+		Where("status IN ?", []string{models.ExportStatusPending, models.ExportStatusRunning}).
+		Updates(map[string]interface{}{"status": models.ExportStatusFailed, "error": reason}).Error
+}
+
+// Exists reports whether an export with the given ID exists
+func (r *ExportRepository) Exists(id uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.DataExport{}).Where("id = ?", id).Count(&count).Error
+	return count > 0, err
+}
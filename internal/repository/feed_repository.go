@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type FeedRepository struct {
+	db *gorm.DB
+}
+
+func NewFeedRepository(db *gorm.DB) *FeedRepository {
+	return &FeedRepository{db: db}
+}
+
+func (r *FeedRepository) Create(feed *models.CalendarFeed) error {
+	return r.db.Create(feed).Error
+}
+
+func (r *FeedRepository) GetByUserID(userID uuid.UUID) (*models.CalendarFeed, error) {
+	var feed models.CalendarFeed
+	err := r.db.First(&feed, "user_id = ?", userID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+// GetByTokenHash returns the feed with the given token hash and its user
+func (r *FeedRepository) GetByTokenHash(tokenHash string) (*models.CalendarFeed, error) {
+	var feed models.CalendarFeed
+	err := r.db.Preload("User").First(&feed, "token_hash = ?", tokenHash).Error
+	if err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+func (r *FeedRepository) Update(feed *models.CalendarFeed) error {
+	return r.db.Omit("User").Save(feed).Error
+}
+
+func (r *FeedRepository) DeleteByUserID(userID uuid.UUID) (int64, error) {
+	result := r.db.Delete(&models.CalendarFeed{}, "user_id = ?", userID)
+	return result.RowsAffected, result.Error
+}
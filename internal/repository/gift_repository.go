@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// giftOrder sorts open gifts by priority and given gifts after them, most
+// recently given first
+const giftOrder = "CASE status WHEN 'given' THEN 1 ELSE 0 END, " +
+	"CASE priority WHEN 'high' THEN 0 WHEN 'medium' THEN 1 ELSE 2 END, " +
+	"given_year DESC, created_at, id"
+
+type GiftRepository struct {
+	db *gorm.DB
+}
+
+func NewGiftRepository(db *gorm.DB) *GiftRepository {
+	return &GiftRepository{db: db}
+}
+
+func (r *GiftRepository) Create(gift *models.Gift) error {
+	return r.db.Omit("Birthday").Create(gift).Error
+}
+
+func (r *GiftRepository) GetByID(id uuid.UUID) (*models.Gift, error) {
+	var gift models.Gift
+	err := r.db.First(&gift, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &gift, nil
+}
+
+// GetByBirthdayIDs returns the gifts for the given birthdays: open gifts by
+// priority and then oldest first, followed by given gifts, most recently
+// given first
+func (r *GiftRepository) GetByBirthdayIDs(birthdayIDs []uuid.UUID) ([]models.Gift, error) {
+	var gifts []models.Gift
+	if len(birthdayIDs) == 0 {
+		return gifts, nil
+	}
+	err := r.db.Where("birthday_id IN ?", birthdayIDs).Order(giftOrder).Find(&gifts).Error
+	return gifts, err
+}
+
+// GetByUserID returns the gifts for the user's birthdays outside the trash,
+// in the order of GetByBirthdayIDs
+func (r *GiftRepository) GetByUserID(userID uuid.UUID) ([]models.Gift, error) {
+	var gifts []models.Gift
+	err := r.db.Where("birthday_id IN (?)",
+		r.db.Model(&models.Birthday{}).Select("id").Where("user_id = ?", userID)).
+		Order(giftOrder).
+		Find(&gifts).Error
+	return gifts, err
+}
+
+func (r *GiftRepository) Update(gift *models.Gift) error {
+	return r.db.Omit("Birthday").Save(gift).Error
+}
+
+func (r *GiftRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Gift{}, "id = ?", id).Error
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type HouseholdRepository struct {
+	db *gorm.DB
+}
+
+func NewHouseholdRepository(db *gorm.DB) *HouseholdRepository {
+	return &HouseholdRepository{db: db}
+}
+
+func (r *HouseholdRepository) Create(household *models.Household) error {
+	return r.db.Omit("User", "Members").Create(household).Error
+}
+
+func (r *HouseholdRepository) GetByID(id uuid.UUID) (*models.Household, error) {
+	var household models.Household
+	err := r.db.First(&household, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &household, nil
+}
+
+// GetByUserID returns the user's households, ordered by name
+func (r *HouseholdRepository) GetByUserID(userID uuid.UUID) ([]models.Household, error) {
+	var households []models.Household
+	err := r.db.Where("user_id = ?", userID).Order("name").Order("id").Find(&households).Error
+	return households, err
+}
+
+func (r *HouseholdRepository) Update(household *models.Household) error {
+	return r.db.Omit("User", "Members").Save(household).Error
+}
+
+// Delete deletes the household. Its members stay, without a household.
+func (r *HouseholdRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Household{}, "id = ?", id).Error
+}
+
+// CountMembers returns the number of birthdays outside the trash in each of
+// the user's households that has any
+func (r *HouseholdRepository) CountMembers(userID uuid.UUID) (map[uuid.UUID]int, error) {
+	var rows []struct {
+		HouseholdID uuid.UUID
+		Count       int
+	}
+	err := r.db.Model(&models.Birthday{}).
+		Select("household_id, COUNT(*) AS count").
+		Where("user_id = ? AND household_id IS NOT NULL", userID).
+		Group("household_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.HouseholdID] = row.Count
+	}
+	return counts, nil
+}
+
+// GetMembers returns the birthdays in the household, ordered by name
+func (r *HouseholdRepository) GetMembers(householdID uuid.UUID) ([]models.Birthday, error) {
+	var birthdays []models.Birthday
+	err := r.db.Scopes(preload).Where("household_id = ?", householdID).Order("name").Order("id").Find(&birthdays).Error
+	return birthdays, err
+}
+
+// SetHousehold moves the birthday into the household, or out of any
+// household when householdID is nil
+func (r *HouseholdRepository) SetHousehold(birthdayID uuid.UUID, householdID *uuid.UUID) error {
+	return r.db.Model(&models.Birthday{}).Where("id = ?", birthdayID).Update("household_id", householdID).Error
+}
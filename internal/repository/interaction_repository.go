@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type InteractionRepository struct {
+	db *gorm.DB
+}
+
+func NewInteractionRepository(db *gorm.DB) *InteractionRepository {
+	return &InteractionRepository{db: db}
+}
+
+func (r *InteractionRepository) Create(interaction *models.Interaction) error {
+	return r.db.Omit("Birthday", "Gift").Create(interaction).Error
+}
+
+func (r *InteractionRepository) GetByID(id uuid.UUID) (*models.Interaction, error) {
+	var interaction models.Interaction
+	err := r.db.First(&interaction, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &interaction, nil
+}
+
+// GetByBirthdayID returns the journal of a birthday, oldest first
+func (r *InteractionRepository) GetByBirthdayID(birthdayID uuid.UUID) ([]models.Interaction, error) {
+	var interactions []models.Interaction
+	err := r.db.Where("birthday_id = ?", birthdayID).
+		Order("occurred_at, created_at, id").
+		Find(&interactions).Error
+	return interactions, err
+}
+
+// GetByUserID returns the journals of the user's birthdays outside the
+// trash, oldest first
+func (r *InteractionRepository) GetByUserID(userID uuid.UUID) ([]models.Interaction, error) {
+	var interactions []models.Interaction
+	err := r.db.Where("birthday_id IN (?)",
+		r.db.Model(&models.Birthday{}).Select("id").Where("user_id = ?", userID)).
+		Order("occurred_at, created_at, id").
+		Find(&interactions).Error
+	return interactions, err
+}
+
+func (r *InteractionRepository) Update(interaction *models.Interaction) error {
+	return r.db.Omit("Birthday", "Gift").Save(interaction).Error
+}
+
+func (r *InteractionRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Interaction{}, "id = ?", id).Error
+}
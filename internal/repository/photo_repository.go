@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type PhotoRepository struct {
+	db *gorm.DB
+}
+
+func NewPhotoRepository(db *gorm.DB) *PhotoRepository {
+	return &PhotoRepository{db: db}
+}
+
+// Transaction runs fn in a database transaction
+func (r *PhotoRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
+
+// WithTx returns a repository that runs its queries in tx
+func (r *PhotoRepository) WithTx(tx *gorm.DB) *PhotoRepository {
+	return &PhotoRepository{db: tx}
+}
+
+func (r *PhotoRepository) Create(photo *models.BirthdayPhoto) error {
+	return r.db.Create(photo).Error
+}
+
+func (r *PhotoRepository) GetByBirthdayID(birthdayID uuid.UUID) (*models.BirthdayPhoto, error) {
+	var photo models.BirthdayPhoto
+	err := r.db.First(&photo, "birthday_id = ?", birthdayID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &photo, nil
+}
+
+// Detach unlinks the birthday's photo, leaving it for RunJanitor to delete
+func (r *PhotoRepository) Detach(birthdayID uuid.UUID) error {
+	return r.db.Model(&models.BirthdayPhoto{}).Where("birthday_id = ?", birthdayID).Update("birthday_id", nil).Error
+}
+
+// GetDetached returns up to limit photos that no longer belong to a birthday
+func (r *PhotoRepository) GetDetached(limit int) ([]models.BirthdayPhoto, error) {
+	var photos []models.BirthdayPhoto
+	err := r.db.Where("birthday_id IS NULL").Order("created_at").Limit(limit).Find(&photos).Error
+	return photos, err
+}
+
+func (r *PhotoRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.BirthdayPhoto{}, "id = ?", id).Error
+}
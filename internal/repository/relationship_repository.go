@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type RelationshipRepository struct {
+	db *gorm.DB
+}
+
+func NewRelationshipRepository(db *gorm.DB) *RelationshipRepository {
+	return &RelationshipRepository{db: db}
+}
+
+// WithTx returns a repository that runs its queries in tx
+func (r *RelationshipRepository) WithTx(tx *gorm.DB) *RelationshipRepository {
+	return &RelationshipRepository{db: tx}
+}
+
+// CreatePair saves both directions of a relationship
+func (r *RelationshipRepository) CreatePair(relationship, inverse *models.BirthdayRelationship) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Birthday", "Related").Create(relationship).Error; err != nil {
+			return err
+		}
+		return tx.Omit("Birthday", "Related").Create(inverse).Error
+	})
+}
+
+func (r *RelationshipRepository) GetByID(id uuid.UUID) (*models.BirthdayRelationship, error) {
+	var relationship models.BirthdayRelationship
+	err := r.db.Preload("Related").First(&relationship, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &relationship, nil
+}
+
+// GetByBirthdayID returns the relatives of a birthday, ordered by name.
+// Relatives in the trash are left out.
+func (r *RelationshipRepository) GetByBirthdayID(birthdayID uuid.UUID) ([]models.BirthdayRelationship, error) {
+	var relationships []models.BirthdayRelationship
+	err := r.db.Select("birthday_relationships.*").
+		Joins("JOIN birthdays ON birthdays.id = birthday_relationships.related_id AND birthdays.deleted_at IS NULL").
+		Where("birthday_relationships.birthday_id = ?", birthdayID).
+		Order("birthdays.name").
+		Preload("Related").
+		Find(&relationships).Error
+	return relationships, err
+}
+
+// GetByUserID returns both directions of every relationship between the
+// user's birthdays outside the trash, oldest first
+func (r *RelationshipRepository) GetByUserID(userID uuid.UUID) ([]models.BirthdayRelationship, error) {
+	var relationships []models.BirthdayRelationship
+	err := r.db.Select("birthday_relationships.*").
+		Joins("JOIN birthdays ON birthdays.id = birthday_relationships.birthday_id AND birthdays.deleted_at IS NULL").
+		Joins("JOIN birthdays related ON related.id = birthday_relationships.related_id AND related.deleted_at IS NULL").
+		Where("birthdays.user_id = ?", userID).
+		Order("birthday_relationships.created_at").
+		Order("birthday_relationships.id").
+		Find(&relationships).Error
+	return relationships, err
+}
+
+// DeletePair deletes both directions of a relationship
+func (r *RelationshipRepository) DeletePair(relationship *models.BirthdayRelationship) error {
+	return r.db.Delete(&models.BirthdayRelationship{},
+		"(birthday_id = ? AND related_id = ?) OR (birthday_id = ? AND related_id = ?)",
+		relationship.BirthdayID, relationship.RelatedID, relationship.RelatedID, relationship.BirthdayID).Error
+}
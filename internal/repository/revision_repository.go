@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type RevisionRepository struct {
+	db *gorm.DB
+}
+
+func NewRevisionRepository(db *gorm.DB) *RevisionRepository {
+	return &RevisionRepository{db: db}
+}
+
+// WithTx returns a repository that runs its queries in tx
+func (r *RevisionRepository) WithTx(tx *gorm.DB) *RevisionRepository {
+	return &RevisionRepository{db: tx}
+}
+
+func (r *RevisionRepository) Create(revision *models.BirthdayRevision) error {
+	return r.db.Create(revision).Error
+}
+
+func (r *RevisionRepository) GetByID(id uuid.UUID) (*models.BirthdayRevision, error) {
+	var revision models.BirthdayRevision
+	err := r.db.First(&revision, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// GetByBirthdayID returns the revisions of a birthday, newest first
+func (r *RevisionRepository) GetByBirthdayID(birthdayID uuid.UUID) ([]models.BirthdayRevision, error) {
+	var revisions []models.BirthdayRevision
+	err := r.db.Where("birthday_id = ?", birthdayID).Order("created_at DESC").Find(&revisions).Error
+	return revisions, err
+}
+
+// GetByUserID returns the revisions of all of the user's birthdays, including
+// the ones in the trash, oldest first.
+func (r *RevisionRepository) GetByUserID(userID uuid.UUID) ([]models.BirthdayRevision, error) {
+	var revisions []models.BirthdayRevision
+	err := r.db.Select("birthday_revisions.*").
+		Joins("JOIN birthdays ON birthdays.id = birthday_revisions.birthday_id").
+		Where("birthdays.user_id = ?", userID).
+		Order("birthday_revisions.created_at").
+		Find(&revisions).Error
+	return revisions, err
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) *TagRepository {
+	return &TagRepository{db: db}
+}
+
+// WithTx returns a repository that runs its queries in tx
+func (r *TagRepository) WithTx(tx *gorm.DB) *TagRepository {
+	return &TagRepository{db: tx}
+}
+
+// CreateIfNotExists inserts tag unless the user already has one with the
+// same name. It reports whether the row was inserted; the statement never
+// fails on the conflict, so it is safe inside a transaction.
+func (r *TagRepository) CreateIfNotExists(tag *models.Tag) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "name"}},
+		DoNothing: true,
+	}).Create(tag)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *TagRepository) GetByID(id uuid.UUID) (*models.Tag, error) {
+	var tag models.Tag
+	err := r.db.First(&tag, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+func (r *TagRepository) GetByName(userID uuid.UUID, name string) (*models.Tag, error) {
+	var tag models.Tag
+	err := r.db.First(&tag, "user_id = ? AND name = ?", userID, name).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// GetByUserIDWithCounts returns the user's tags ordered by name, each with the
+// number of birthdays carrying it.
+func (r *TagRepository) GetByUserIDWithCounts(userID uuid.UUID) ([]models.TagResponse, error) {
+	var tags []models.TagResponse
+	err := r.db.Model(&models.Tag{}).
+		Select("tags.id, tags.name, tags.created_at, COUNT(birthdays.id) AS birthday_count").
+		Joins("LEFT JOIN birthday_tags ON birthday_tags.tag_id = tags.id").
+		Joins("LEFT JOIN birthdays ON birthdays.id = birthday_tags.birthday_id AND birthdays.deleted_at IS NULL").
+		Where("tags.user_id = ?", userID).
+		Group("tags.id").
+		Order("tags.name").
+		Scan(&tags).Error
+	return tags, err
+}
+
+func (r *TagRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Tag{}, "id = ?", id).Error
+}
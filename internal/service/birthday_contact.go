@@ -0,0 +1,207 @@
+package service
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+)
+
+// MaxContactEntries limits each kind of contact detail of a birthday
+const MaxContactEntries = 20
+
+var (
+	phoneTypes   = []string{models.ContactTypeMobile, models.ContactTypeHome, models.ContactTypeWork, models.ContactTypeOther}
+	emailTypes   = []string{models.ContactTypePersonal, models.ContactTypeWork, models.ContactTypeOther}
+	addressTypes = []string{models.ContactTypeHome, models.ContactTypeWork, models.ContactTypeOther}
+
+	phonePattern   = regexp.MustCompile(`^\+?[0-9 ().\-/]+$`)
+	networkPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9.\-]{0,29}$`)
+)
+
+// NormalizeContact validates the contact details of req and returns them
+// cleaned up: whitespace is trimmed, types default to "other", country codes
+// are upper-cased and duplicates are dropped. Lists that are nil in req stay
+// nil, meaning they are left unchanged.
+func NormalizeContact(req *models.CreateBirthdayRequest) (*models.ContactDetails, error) {
+	phones, err := normalizePhones(req.Phones)
+	if err != nil {
+		return nil, err
+	}
+	emails, err := normalizeEmails(req.Emails)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := normalizeAddresses(req.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	socialHandles, err := normalizeSocialHandles(req.SocialHandles)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ContactDetails{
+		Phones:        phones,
+		Emails:        emails,
+		Addresses:     addresses,
+		SocialHandles: socialHandles,
+	}, nil
+}
+
+// contactType validates the type of the i-th entry of field
+func contactType(field string, i int, value string, allowed []string) (string, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return models.ContactTypeOther, nil
+	}
+	for _, t := range allowed {
+		if value == t {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%s[%d]: type must be one of %s", field, i, strings.Join(allowed, ", "))
+}
+
+func checkContactCount(field string, n int) error {
+	if n > MaxContactEntries {
+		return fmt.Errorf("at most %d %s are allowed", MaxContactEntries, strings.ReplaceAll(field, "_", " "))
+	}
+	return nil
+}
+
+func normalizePhones(phones []models.PhoneNumber) ([]models.PhoneNumber, error) {
+	if phones == nil {
+		return nil, nil
+	}
+	result := []models.PhoneNumber{}
+	seen := map[string]bool{}
+	for i, phone := range phones {
+		t, err := contactType("phones", i, phone.Type, phoneTypes)
+		if err != nil {
+			return nil, err
+		}
+		number := strings.Join(strings.Fields(phone.Number), " ")
+		digits := models.PhoneDigits(number)
+		if !phonePattern.MatchString(number) || len(digits) < 3 || len(digits) > 20 {
+			return nil, fmt.Errorf("phones[%d]: invalid phone number %q", i, phone.Number)
+		}
+		if !seen[digits] {
+			seen[digits] = true
+			result = append(result, models.PhoneNumber{Type: t, Number: number})
+		}
+	}
+	return result, checkContactCount("phones", len(result))
+}
+
+func normalizeEmails(emails []models.EmailAddress) ([]models.EmailAddress, error) {
+	if emails == nil {
+		return nil, nil
+	}
+	result := []models.EmailAddress{}
+	seen := map[string]bool{}
+	for i, email := range emails {
+		t, err := contactType("emails", i, email.Type, emailTypes)
+		if err != nil {
+			return nil, err
+		}
+		address := strings.TrimSpace(email.Address)
+		parsed, err := mail.ParseAddress(address)
+		if err != nil || parsed.Address != address || len(address) > 254 {
+			return nil, fmt.Errorf("emails[%d]: invalid email address %q", i, email.Address)
+		}
+		if key := strings.ToLower(address); !seen[key] {
+			seen[key] = true
+			result = append(result, models.EmailAddress{Type: t, Address: address})
+		}
+	}
+	return result, checkContactCount("emails", len(result))
+}
+
+func normalizeAddresses(addresses []models.PostalAddress) ([]models.PostalAddress, error) {
+	if addresses == nil {
+		return nil, nil
+	}
+	result := []models.PostalAddress{}
+	seen := map[models.PostalAddress]bool{}
+	for i, address := range addresses {
+		t, err := contactType("addresses", i, address.Type, addressTypes)
+		if err != nil {
+			return nil, err
+		}
+
+		normalized, err := NormalizeAddress(address.Address)
+		if err != nil {
+			return nil, fmt.Errorf("addresses[%d]: %w", i, err)
+		}
+		if normalized == (models.Address{}) {
+			return nil, fmt.Errorf("addresses[%d]: address is empty", i)
+		}
+
+		if entry := (models.PostalAddress{Type: t, Address: normalized}); !seen[entry] {
+			seen[entry] = true
+			result = append(result, entry)
+		}
+	}
+	return result, checkContactCount("addresses", len(result))
+}
+
+// NormalizeAddress validates address and returns it with whitespace trimmed,
+// empty street lines removed and the country code upper-cased
+func NormalizeAddress(address models.Address) (models.Address, error) {
+	var lines []string
+	for _, line := range strings.Split(address.Street, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	normalized := models.Address{
+		Street:     strings.Join(lines, "\n"),
+		City:       strings.TrimSpace(address.City),
+		Region:     strings.TrimSpace(address.Region),
+		PostalCode: strings.TrimSpace(address.PostalCode),
+		Country:    strings.ToUpper(strings.TrimSpace(address.Country)),
+	}
+
+	switch {
+	case len(normalized.Street) > 200:
+		return normalized, fmt.Errorf("street must not exceed 200 characters")
+	case len(normalized.City) > 100 || len(normalized.Region) > 100:
+		return normalized, fmt.Errorf("city and region must not exceed 100 characters")
+	case len(normalized.PostalCode) > 20:
+		return normalized, fmt.Errorf("postal code must not exceed 20 characters")
+	case normalized.Country != "" && !isCountryCode(normalized.Country):
+		return normalized, fmt.Errorf("country must be an ISO 3166-1 alpha-2 code such as DE")
+	}
+	return normalized, nil
+}
+
+func isCountryCode(value string) bool {
+	return len(value) == 2 && 'A' <= value[0] && value[0] <= 'Z' && 'A' <= value[1] && value[1] <= 'Z'
+}
+
+func normalizeSocialHandles(handles []models.SocialHandle) ([]models.SocialHandle, error) {
+	if handles == nil {
+		return nil, nil
+	}
+	result := []models.SocialHandle{}
+	seen := map[string]bool{}
+	for i, social := range handles {
+		network := strings.ToLower(strings.TrimSpace(social.Network))
+		if !networkPattern.MatchString(network) {
+			return nil, fmt.Errorf("social_handles[%d]: network must be 1-30 lower-case letters, digits, dots or dashes", i)
+		}
+		handle := strings.TrimPrefix(strings.TrimSpace(social.Handle), "@")
+		if handle == "" || len(handle) > 100 || strings.ContainsFunc(handle, unicode.IsSpace) {
+			return nil, fmt.Errorf("social_handles[%d]: invalid handle %q", i, social.Handle)
+		}
+		if key := network + "\n" + strings.ToLower(handle); !seen[key] {
+			seen[key] = true
+			result = append(result, models.SocialHandle{Network: network, Handle: handle})
+		}
+	}
+	return result, checkContactCount("social_handles", len(result))
+}
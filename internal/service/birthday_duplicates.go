@@ -0,0 +1,325 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// nameSimilarityThreshold is the minimum similarity, from 0 to 1, at which
+// two normalized names with the same birthday are considered duplicates.
+const nameSimilarityThreshold = 0.8
+
+var ErrInvalidMerge = errors.New("invalid merge")
+
+// FindDuplicates groups the user's events of eventType (every type when
+// empty) that probably describe the same occasion: they have the same type,
+// fall on the same month and day and have similar names. Groups are ordered
+// by date and their birthdays oldest first.
+func (s *BirthdayService) FindDuplicates(userID uuid.UUID, cal models.Calendar, eventType string) ([]*models.DuplicateGroupResponse, error) {
+	birthdays, err := s.repo.GetByUserIDAndType(userID, eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(birthdays, func(i, j int) bool {
+		a, b := &birthdays[i], &birthdays[j]
+		if a.BirthMonth != b.BirthMonth {
+			return a.BirthMonth < b.BirthMonth
+		}
+		if a.BirthDay != b.BirthDay {
+			return a.BirthDay < b.BirthDay
+		}
+		if a.Type().Key != b.Type().Key {
+			return a.Type().Key < b.Type().Key
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+
+	groups := []*models.DuplicateGroupResponse{}
+	for start := 0; start < len(birthdays); {
+		end := start + 1
+		for end < len(birthdays) && birthdays[end].BirthMonth == birthdays[start].BirthMonth &&
+			birthdays[end].BirthDay == birthdays[start].BirthDay && birthdays[end].Type().Key == birthdays[start].Type().Key {
+			end++
+		}
+		for _, members := range similarNames(birthdays[start:end]) {
+			group := &models.DuplicateGroupResponse{
+				BirthMonth: birthdays[start].BirthMonth,
+				BirthDay:   birthdays[start].BirthDay,
+				Birthdays:  make([]*models.BirthdayResponse, len(members)),
+			}
+			for i, member := range members {
+				group.Birthdays[i] = birthdays[start+member].ToResponse(cal)
+			}
+			groups = append(groups, group)
+		}
+		start = end
+	}
+
+	return groups, nil
+}
+
+// similarNames partitions birthdays into groups of two or more with similar
+// names, returning the indices of each group's members in order. Similarity
+// is transitive: "Jon Doe", "John Doe" and "Doe, John" end up in one group.
+func similarNames(birthdays []models.Birthday) [][]int {
+	names := make([]string, len(birthdays))
+	for i := range birthdays {
+		names[i] = normalizeName(birthdays[i].Name)
+	}
+
+	parent := make([]int, len(birthdays))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := range names {
+		for j := i + 1; j < len(names); j++ {
+			if namesSimilar(names[i], names[j]) {
+				if a, b := find(i), find(j); a != b {
+					parent[max(a, b)] = min(a, b)
+				}
+			}
+		}
+	}
+
+	members := make(map[int][]int)
+	var roots []int
+	for i := range birthdays {
+		root := find(i)
+		if members[root] == nil {
+			roots = append(roots, root)
+		}
+		members[root] = append(members[root], i)
+	}
+
+	var groups [][]int
+	for _, root := range roots {
+		if len(members[root]) > 1 {
+			groups = append(groups, members[root])
+		}
+	}
+	return groups
+}
+
+// normalizeName lowercases name, replaces punctuation with spaces and
+// collapses runs of whitespace.
+func normalizeName(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// namesSimilar reports whether two normalized names probably name the same
+// person: they are equal, contain the same words in a different order, one
+// contains all the words of the other, or they differ by a few typos.
+func namesSimilar(a, b string) bool {
+	if a == b {
+		return true
+	}
+	wordsA, wordsB := strings.Fields(a), strings.Fields(b)
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return false
+	}
+	if len(wordsA) > len(wordsB) {
+		wordsA, wordsB = wordsB, wordsA
+	}
+	if containsWords(wordsB, wordsA) {
+		return true
+	}
+
+	sort.Strings(wordsA)
+	sort.Strings(wordsB)
+	sortedA, sortedB := strings.Join(wordsA, " "), strings.Join(wordsB, " ")
+	return nameSimilarity(sortedA, sortedB) >= nameSimilarityThreshold
+}
+
+// containsWords reports whether every word of subset occurs in words
+func containsWords(words, subset []string) bool {
+	counts := make(map[string]int, len(words))
+	for _, word := range words {
+		counts[word]++
+	}
+	for _, word := range subset {
+		if counts[word] == 0 {
+			return false
+		}
+		counts[word]--
+	}
+	return true
+}
+
+// nameSimilarity returns 1 minus the Levenshtein distance between a and b
+// relative to the length of the longer one.
+func nameSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return 1 - float64(prev[len(rb)])/float64(longest)
+}
+
+// MergeBirthdays merges the birthdays req.SourceIDs into req.SurvivorID and
+// moves them to the trash, recording a deleted revision for each, all in one
+// transaction; all of them must be events of the same type. Name, birth date
+// and category are taken from the birthday req.Fields names, defaulting to
+// the survivor; when the survivor's birth year is unknown and the birth date
+// was not chosen, it is filled in from a source with the same date. Notes are
+// concatenated, survivor first, and tags and contact details are combined. A
+// survivor without a household joins the household of the first source that
+// has one, gifts, journal entries, celebrations and relationships of the
+// sources are moved to the survivor and their budgets are added to the
+// survivor's.
+func (s *BirthdayService) MergeBirthdays(userID uuid.UUID, req *models.MergeBirthdaysRequest) (*models.Birthday, error) {
+	records := make(map[uuid.UUID]*models.Birthday, len(req.SourceIDs)+1)
+	load := func(id uuid.UUID) (*models.Birthday, error) {
+		if _, ok := records[id]; ok {
+			if id == req.SurvivorID {
+				return nil, fmt.Errorf("%w: source_ids must not contain survivor_id", ErrInvalidMerge)
+			}
+			return nil, fmt.Errorf("%w: source_ids contains %s more than once", ErrInvalidMerge, id)
+		}
+		birthday, err := s.repo.GetByID(id)
+		if err != nil || birthday.UserID != userID {
+			return nil, ErrBirthdayNotFound
+		}
+		records[id] = birthday
+		return birthday, nil
+	}
+
+	survivor, err := load(req.SurvivorID)
+	if err != nil {
+		return nil, err
+	}
+	sources := make([]*models.Birthday, len(req.SourceIDs))
+	for i, id := range req.SourceIDs {
+		if sources[i], err = load(id); err != nil {
+			return nil, err
+		}
+		if sources[i].Type().Key != survivor.Type().Key {
+			return nil, fmt.Errorf("%w: %s has event type %s, the survivor %s", ErrInvalidMerge, id, sources[i].Type().Key, survivor.Type().Key)
+		}
+	}
+
+	choose := func(field string, id *uuid.UUID) (*models.Birthday, error) {
+		if id == nil {
+			return survivor, nil
+		}
+		birthday, ok := records[*id]
+		if !ok {
+			return nil, fmt.Errorf("%w: fields.%s must be survivor_id or one of source_ids", ErrInvalidMerge, field)
+		}
+		return birthday, nil
+	}
+
+	merged := survivor.ToRequest()
+	name, err := choose("name", req.Fields.Name)
+	if err != nil {
+		return nil, err
+	}
+	merged.Name = name.Name
+
+	birthDate, err := choose("birth_date", req.Fields.BirthDate)
+	if err != nil {
+		return nil, err
+	}
+	if req.Fields.BirthDate == nil && survivor.BirthYear == nil {
+		for _, source := range sources {
+			if source.BirthYear != nil && source.BirthMonth == survivor.BirthMonth && source.BirthDay == survivor.BirthDay {
+				birthDate = source
+				break
+			}
+		}
+	}
+	merged.BirthDate = birthDate.FormatBirthDate()
+
+	category, err := choose("category", req.Fields.Category)
+	if err != nil {
+		return nil, err
+	}
+	merged.Category = category.Category
+
+	notes := []string{}
+	seenNotes := map[string]bool{}
+	for _, birthday := range append([]*models.Birthday{survivor}, sources...) {
+		note := strings.TrimSpace(birthday.Notes)
+		if note != "" && !seenNotes[note] {
+			seenNotes[note] = true
+			notes = append(notes, note)
+		}
+		merged.Tags = append(merged.Tags, birthday.TagNames()...)
+		if birthday != survivor {
+			merged.Phones = append(merged.Phones, birthday.Contact.Phones...)
+			merged.Emails = append(merged.Emails, birthday.Contact.Emails...)
+			merged.Addresses = append(merged.Addresses, birthday.Contact.Addresses...)
+			merged.SocialHandles = append(merged.SocialHandles, birthday.Contact.SocialHandles...)
+		}
+		if survivor.SourceUID == nil {
+			survivor.SourceUID = birthday.SourceUID
+		}
+		if survivor.HouseholdID == nil {
+			survivor.HouseholdID = birthday.HouseholdID
+		}
+	}
+	merged.Notes = strings.Join(notes, "\n\n")
+
+	err = s.repo.Transaction(func(tx *gorm.DB) error {
+		txService := s.withTx(tx)
+		if _, err := txService.update(userID, models.RevisionMerged, survivor, merged, nil); err != nil {
+			// The combined fields may not be valid together, e.g. too
+			// many contact details; that is a problem with the request.
+			if errors.Is(err, ErrInvalidBirthday) {
+				return fmt.Errorf("%w: %w", ErrInvalidMerge, err)
+			}
+			return err
+		}
+		if err := txService.repo.Merge(survivor.ID, req.SourceIDs); err != nil {
+			return err
+		}
+		for _, source := range sources {
+			before := source.Snapshot()
+			if err := txService.record(userID, models.RevisionDeleted, source, &before); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(survivor.ID)
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Jane Doe", want: "jane doe"},
+		{name: "  DOE,   Jane ", want: "doe jane"},
+		{name: "Jean-Luc O'Neill", want: "jean luc o neill"},
+		{name: "Müller", want: "müller"},
+		{name: "!!!", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeName(tt.name); got != tt.want {
+				t.Errorf("normalizeName(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNamesSimilar(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "jane doe", b: "jane doe", want: true},
+		{a: "doe jane", b: "jane doe", want: true},
+		{a: "jane", b: "jane doe", want: true},
+		{a: "jane doe", b: "jane mary doe", want: true},
+		{a: "jon doe", b: "john doe", want: true},
+		{a: "jane doe", b: "jane doee", want: true},
+		{a: "jane doe", b: "john doe", want: false},
+		{a: "jane doe", b: "jane smith", want: false},
+		{a: "ann", b: "anna", want: false},
+		{a: "jane jane", b: "jane", want: true},
+		{a: "jane", b: "jane jane", want: true},
+		{a: "", b: "jane", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			if got := namesSimilar(tt.a, tt.b); got != tt.want {
+				t.Errorf("namesSimilar(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{a: "", b: "", want: 1},
+		{a: "abc", b: "abc", want: 1},
+		{a: "abc", b: "", want: 0},
+		{a: "doe jon", b: "doe john", want: 1 - 1.0/8},
+		{a: "kitten", b: "sitting", want: 1 - 3.0/7},
+		{a: "müller", b: "muller", want: 1 - 1.0/6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			if got := nameSimilarity(tt.a, tt.b); got != tt.want {
+				t.Errorf("nameSimilarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameSimilarityThreshold(t *testing.T) {
+	// One typo in five letters stays at the threshold, two do not.
+	if got := nameSimilarity("abcde", "abcdx"); got < nameSimilarityThreshold {
+		t.Errorf("nameSimilarity with one typo in five letters = %v, want at least %v", got, nameSimilarityThreshold)
+	}
+	if got := nameSimilarity("abcde", "abcxy"); got >= nameSimilarityThreshold {
+		t.Errorf("nameSimilarity with two typos in five letters = %v, want below %v", got, nameSimilarityThreshold)
+	}
+}
+
+func TestSimilarNames(t *testing.T) {
+	tests := []struct {
+		name  string
+		names []string
+		want  [][]int
+	}{
+		{name: "no birthdays", names: nil, want: nil},
+		{name: "single birthday", names: []string{"Jane Doe"}, want: nil},
+		{name: "all different", names: []string{"Jane Doe", "John Smith", "Max Mustermann"}, want: nil},
+		{name: "pair", names: []string{"Jane Doe", "John Smith", "Doe, Jane"}, want: [][]int{{0, 2}}},
+		{name: "two groups", names: []string{"Jane Doe", "John Smith", "jane doe", "Smith John"}, want: [][]int{{0, 2}, {1, 3}}},
+		// "Jon" and "Doe, John" are not similar to each other, but both are
+		// similar to "Jon Doe", so all three form one group.
+		{name: "transitive", names: []string{"Jon", "Anna Berg", "Doe, John", "Jon Doe"}, want: [][]int{{0, 2, 3}}},
+		{name: "transitive through later member", names: []string{"Doe, John", "Jon", "Jon Doe"}, want: [][]int{{0, 1, 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			birthdays := make([]models.Birthday, len(tt.names))
+			for i, name := range tt.names {
+				birthdays[i].Name = name
+			}
+			if got := similarNames(birthdays); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("similarNames(%q) = %v, want %v", tt.names, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,477 @@
+package service
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/ical"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/vcard"
+	"gorm.io/gorm"
+)
+
+// MaxImportRows is the maximum number of entries accepted in one import
+const MaxImportRows = 5000
+
+var (
+	ErrInvalidImport = errors.New("invalid import file")
+	errDryRun        = errors.New("dry run")
+)
+
+// csvColumns are the columns written by ExportCSV and read by ImportCSV
+// unless the import maps them to other headers.
+var csvColumns = []string{"name", "birth_date", "category", "notes", "event_type", "partner_name"}
+
+// importRecord is one entry read from an import file. Err is set when the
+// entry could not be read into a request, Skip when there is nothing to
+// import. SourceUID identifies the entry in the system it came from.
+type importRecord struct {
+	Row       int
+	Request   models.CreateBirthdayRequest
+	Err       error
+	Skip      string
+	SourceUID string
+}
+
+// importRecords saves records in one transaction, each in its own savepoint
+// so that an invalid entry does not affect the others. Records without a
+// category get opts.DefaultCategory, and existing birthdays with the same
+// source UID, or else the same name (case-insensitive) and event type, are
+// handled according to opts.OnDuplicate. When eventType is set, records
+// without an event type get it and records of other types are invalid. In
+// dry-run mode the transaction is rolled back and the report describes what
+// would have happened.
+func (s *BirthdayService) importRecords(userID uuid.UUID, eventType string, records []importRecord, opts *models.ImportOptions) (*models.ImportReport, error) {
+	dryRun := opts.DryRun
+	onDuplicate := opts.OnDuplicate
+	if onDuplicate == "" {
+		onDuplicate = models.DuplicateSkip
+	}
+
+	report := &models.ImportReport{DryRun: dryRun, Rows: []models.ImportRowResult{}}
+
+	err := s.repo.Transaction(func(tx *gorm.DB) error {
+		txService := s.withTx(tx)
+		for i := range records {
+			record := &records[i]
+			result := models.ImportRowResult{Row: record.Row, Name: strings.TrimSpace(record.Request.Name)}
+
+			if record.Err != nil {
+				result.Status = models.ImportStatusInvalid
+				result.Error = record.Err.Error()
+				report.Add(result)
+				continue
+			}
+			if record.Skip != "" {
+				result.Status = models.ImportStatusSkipped
+				result.Error = record.Skip
+				report.Add(result)
+				continue
+			}
+			if eventType != "" && record.Request.EventType != "" && record.Request.EventType != eventType {
+				result.Status = models.ImportStatusInvalid
+				result.Error = fmt.Sprintf("event_type %q cannot be imported here, use the /events imports for other event types", record.Request.EventType)
+				report.Add(result)
+				continue
+			}
+			if eventType != "" {
+				record.Request.EventType = eventType
+			}
+			if strings.TrimSpace(record.Request.Category) == "" {
+				record.Request.Category = strings.TrimSpace(opts.DefaultCategory)
+			}
+
+			err := txService.repo.Transaction(func(savepoint *gorm.DB) error {
+				id, status, err := s.withTx(savepoint).importRecord(userID, record, onDuplicate)
+				result.ID = id
+				result.Status = status
+				return err
+			})
+			if err != nil {
+				result.ID = nil
+				result.Status = models.ImportStatusInvalid
+				result.Error = err.Error()
+			} else if result.Status == models.ImportStatusSkipped {
+				result.Error = "already imported or a birthday with this name exists"
+			}
+			if dryRun {
+				result.ID = nil
+			}
+			report.Add(result)
+		}
+
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && err != errDryRun {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func (s *BirthdayService) importRecord(userID uuid.UUID, record *importRecord, onDuplicate string) (*uuid.UUID, string, error) {
+	req := &record.Request
+	if err := ValidateBirthdayRequest(req); err != nil {
+		return nil, "", err
+	}
+
+	var sourceUID *string
+	var previous *models.Birthday
+	if record.SourceUID != "" {
+		sourceUID = &record.SourceUID
+		if birthday, err := s.repo.GetBySourceUID(userID, record.SourceUID); err == nil {
+			previous = birthday
+		}
+	}
+
+	var sameName []models.Birthday
+	if previous == nil && onDuplicate != models.DuplicateCreate {
+		var err error
+		if sameName, err = s.repo.FindByName(userID, req.Name); err != nil {
+			return nil, "", err
+		}
+	}
+
+	birthday, status := importMatch(previous, sameName, req.EventType, onDuplicate)
+	switch status {
+	case models.ImportStatusSkipped:
+		return &birthday.ID, status, nil
+	case models.ImportStatusUpdated:
+		if birthday.SourceUID == nil {
+			birthday.SourceUID = sourceUID
+		}
+		if _, err := s.UpdateBirthday(userID, birthday, mergeImportRequest(birthday, req)); err != nil {
+			return nil, "", err
+		}
+		return &birthday.ID, status, nil
+	}
+
+	birthday, err := s.create(userID, &models.Birthday{UserID: userID, SourceUID: sourceUID}, req)
+	if err != nil {
+		return nil, "", err
+	}
+	return &birthday.ID, models.ImportStatusCreated, nil
+}
+
+// importMatch decides what happens to an import entry of eventType (birthday
+// when empty). previous is the birthday imported before from the same source
+// UID, if any, and sameName the user's birthdays with the same name. It
+// returns the birthday to skip or update with the import status, or nil and
+// ImportStatusCreated when a new birthday is created.
+func importMatch(previous *models.Birthday, sameName []models.Birthday, eventType, onDuplicate string) (*models.Birthday, string) {
+	// Entries seen before are never created twice, so that importing the
+	// same file again changes nothing.
+	if previous != nil {
+		if onDuplicate == models.DuplicateUpdate {
+			return previous, models.ImportStatusUpdated
+		}
+		return previous, models.ImportStatusSkipped
+	}
+
+	if onDuplicate == models.DuplicateCreate {
+		return nil, models.ImportStatusCreated
+	}
+	if eventType == "" {
+		eventType = models.EventTypeBirthday
+	}
+	for i := range sameName {
+		if sameName[i].Type().Key != eventType {
+			continue
+		}
+		if onDuplicate == models.DuplicateSkip {
+			return &sameName[i], models.ImportStatusSkipped
+		}
+		return &sameName[i], models.ImportStatusUpdated
+	}
+	return nil, models.ImportStatusCreated
+}
+
+// mergeImportRequest overlays the non-blank fields of req on birthday. The
+// birthday's tags are kept and req's tags are added to them.
+func mergeImportRequest(birthday *models.Birthday, req *models.CreateBirthdayRequest) *models.CreateBirthdayRequest {
+	merged := birthday.ToRequest()
+	if strings.TrimSpace(req.BirthDate) != "" {
+		merged.BirthDate = req.BirthDate
+	}
+	if req.EventType != "" {
+		merged.EventType = req.EventType
+	}
+	if strings.TrimSpace(req.PartnerName) != "" {
+		merged.PartnerName = req.PartnerName
+	}
+	if strings.TrimSpace(req.Category) != "" {
+		merged.Category = req.Category
+	}
+	if strings.TrimSpace(req.Notes) != "" {
+		merged.Notes = req.Notes
+	}
+	merged.Tags = append(merged.Tags, req.Tags...)
+	return merged
+}
+
+// ImportCSV imports birthdays from a CSV file with a header row. Columns are
+// matched to headers case-insensitively; opts can map each field to another
+// header name. The optional event_type and partner_name columns import other
+// kinds of events; rows without an event type are birthdays, or of eventType
+// when it is set.
+func (s *BirthdayService) ImportCSV(userID uuid.UUID, eventType string, r io.Reader, opts *models.CSVImportOptions) (*models.ImportReport, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w: file is empty", ErrInvalidImport)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		key := strings.ToLower(strings.TrimSpace(name))
+		if _, ok := positions[key]; !ok {
+			positions[key] = i
+		}
+	}
+
+	column := func(mapped, fallback string, required bool) (int, error) {
+		name := fallback
+		if strings.TrimSpace(mapped) != "" {
+			name = mapped
+		}
+		if i, ok := positions[strings.ToLower(strings.TrimSpace(name))]; ok {
+			return i, nil
+		}
+		if required || strings.TrimSpace(mapped) != "" {
+			return 0, fmt.Errorf("%w: missing column %q", ErrInvalidImport, name)
+		}
+		return -1, nil
+	}
+
+	nameCol, err := column(opts.NameColumn, "name", true)
+	if err != nil {
+		return nil, err
+	}
+	dateCol, err := column(opts.BirthDateColumn, "birth_date", true)
+	if err != nil {
+		return nil, err
+	}
+	categoryCol, err := column(opts.CategoryColumn, "category", false)
+	if err != nil {
+		return nil, err
+	}
+	notesCol, err := column(opts.NotesColumn, "notes", false)
+	if err != nil {
+		return nil, err
+	}
+	eventTypeCol, _ := column("", "event_type", false)
+	partnerCol, _ := column("", "partner_name", false)
+
+	var records []importRecord
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			records = append(records, importRecord{Row: parseErr.StartLine, Err: parseErr.Err})
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+		} else if isBlankRow(row) {
+			continue
+		} else {
+			cell := func(i int) string {
+				if i < 0 || i >= len(row) {
+					return ""
+				}
+				return unescapeCSVCell(strings.TrimSpace(row[i]))
+			}
+			req := models.CreateBirthdayRequest{
+				Name:        cell(nameCol),
+				BirthDate:   cell(dateCol),
+				EventType:   strings.ToLower(cell(eventTypeCol)),
+				PartnerName: cell(partnerCol),
+				Category:    cell(categoryCol),
+				Notes:       cell(notesCol),
+			}
+			line, _ := reader.FieldPos(0)
+			records = append(records, importRecord{Row: line, Request: req})
+		}
+
+		if len(records) > MaxImportRows {
+			return nil, fmt.Errorf("%w: more than %d rows", ErrInvalidImport, MaxImportRows)
+		}
+	}
+
+	return s.importRecords(userID, eventType, records, &opts.ImportOptions)
+}
+
+// ImportVCard imports the contacts of a vCard file. FN (or N) becomes the
+// name and BDAY the birth date; the first CATEGORIES value becomes the
+// category and the others become tags. Contacts without BDAY are skipped.
+func (s *BirthdayService) ImportVCard(userID uuid.UUID, eventType string, r io.Reader, opts *models.ImportOptions) (*models.ImportReport, error) {
+	cards, err := vcard.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+	if len(cards) > MaxImportRows {
+		return nil, fmt.Errorf("%w: more than %d contacts", ErrInvalidImport, MaxImportRows)
+	}
+
+	records := make([]importRecord, len(cards))
+	for i, card := range cards {
+		record := importRecord{Row: card.Position, Request: models.CreateBirthdayRequest{Name: card.Name}}
+		if len(card.Categories) > 0 {
+			record.Request.Category = card.Categories[0]
+			record.Request.Tags = card.Categories[1:]
+		}
+
+		if card.Birthday == "" {
+			record.Skip = "contact has no birthday"
+		} else if birthDate, err := card.BirthDate(); err != nil {
+			record.Err = err
+		} else {
+			record.Request.BirthDate = birthDate
+		}
+		records[i] = record
+	}
+
+	return s.importRecords(userID, eventType, records, opts)
+}
+
+// ImportICS imports the yearly recurring events of an iCalendar file.
+// SUMMARY becomes the name and event type when it is worded like the
+// summaries of the calendar feed ("Jane's birthday", "John & Jane's wedding
+// anniversary"), and the name of a birthday otherwise. DTSTART becomes the
+// birth date, DESCRIPTION the notes, and the first CATEGORIES value the
+// category with the others as tags. Events are matched to earlier imports by
+// UID. Years before 1900 are treated as unknown, and opts.IgnoreYear drops
+// the year of every event for calendars that start the series when the
+// event was created rather than at birth.
+func (s *BirthdayService) ImportICS(userID uuid.UUID, eventType string, r io.Reader, opts *models.ICSImportOptions) (*models.ImportReport, error) {
+	events, err := ical.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+	if len(events) > MaxImportRows {
+		return nil, fmt.Errorf("%w: more than %d events", ErrInvalidImport, MaxImportRows)
+	}
+
+	records := make([]importRecord, len(events))
+	for i, event := range events {
+		record := importRecord{
+			Row:       i + 1,
+			SourceUID: event.UID,
+			Request:   models.CreateBirthdayRequest{Notes: event.Description},
+		}
+		name, partnerName, eventType, ok := models.ParseTitle(event.Summary)
+		record.Request.Name = name
+		if ok {
+			record.Request.EventType = eventType.Key
+			record.Request.PartnerName = partnerName
+		}
+		if len(event.Categories) > 0 {
+			record.Request.Category = event.Categories[0]
+			record.Request.Tags = event.Categories[1:]
+		}
+
+		rule := ical.RRuleParts(event.RRule)
+		if rule["FREQ"] != "YEARLY" {
+			record.Skip = "not a yearly recurring event"
+		} else if event.Date.IsZero() {
+			record.Err = fmt.Errorf("missing or invalid DTSTART")
+		} else {
+			record.Request.BirthDate = eventBirthDate(event.Date, rule, opts.IgnoreYear)
+		}
+		records[i] = record
+	}
+
+	return s.importRecords(userID, eventType, records, &opts.ImportOptions)
+}
+
+// eventBirthDate returns the birth date of a yearly event. Rules that recur
+// on the last day of February or the 60th day of the year are Feb 29
+// birthdays, whatever the year of DTSTART.
+func eventBirthDate(date time.Time, rule map[string]string, ignoreYear bool) string {
+	month, day := int(date.Month()), date.Day()
+	if rule["BYYEARDAY"] == "60" || (rule["BYMONTH"] == "2" && (rule["BYMONTHDAY"] == "-1" || rule["BYMONTHDAY"] == "29")) {
+		month, day = 2, 29
+	}
+
+	if ignoreYear || date.Year() < minBirthYear || (month == 2 && day == 29 && !models.IsLeapYear(date.Year())) {
+		return fmt.Sprintf("%02d-%02d", month, day)
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", date.Year(), month, day)
+}
+
+// ExportCSV writes the user's events of eventType (every type when empty),
+// ordered by name, as CSV in the format accepted by ImportCSV.
+func (s *BirthdayService) ExportCSV(userID uuid.UUID, eventType string, w io.Writer) error {
+	birthdays, err := s.repo.GetByUserIDAndType(userID, eventType)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(birthdays, func(i, j int) bool {
+		return strings.ToLower(birthdays[i].Name) < strings.ToLower(birthdays[j].Name)
+	})
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvColumns); err != nil {
+		return err
+	}
+	for _, birthday := range birthdays {
+		err := writer.Write([]string{
+			escapeCSVCell(birthday.Name),
+			birthday.FormatBirthDate(),
+			escapeCSVCell(birthday.Category),
+			escapeCSVCell(birthday.Notes),
+			birthday.Type().Key,
+			escapeCSVCell(birthday.PartnerName),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// escapeCSVCell prefixes cells that spreadsheet programs would evaluate as
+// formulas with a single quote. unescapeCSVCell reverses it on import.
+func escapeCSVCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func unescapeCSVCell(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsRune("=+-@\t\r", rune(value[1])) {
+		return value[1:]
+	}
+	return value
+}
+
+func isBlankRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
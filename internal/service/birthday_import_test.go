@@ -0,0 +1,81 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+)
+
+func TestImportMatch(t *testing.T) {
+	previous := &models.Birthday{ID: uuid.New(), Name: "Jane", EventType: models.EventTypeBirthday}
+	birthday := models.Birthday{ID: uuid.New(), Name: "Jane", EventType: models.EventTypeBirthday}
+	legacy := models.Birthday{ID: uuid.New(), Name: "Jane"}
+	memorial := models.Birthday{ID: uuid.New(), Name: "Jane", EventType: models.EventTypeMemorial}
+
+	tests := []struct {
+		name        string
+		previous    *models.Birthday
+		sameName    []models.Birthday
+		eventType   string
+		onDuplicate string
+		want        *uuid.UUID
+		wantStatus  string
+	}{
+		{name: "same UID is skipped", previous: previous, onDuplicate: models.DuplicateSkip, want: &previous.ID, wantStatus: models.ImportStatusSkipped},
+		{name: "same UID is updated", previous: previous, onDuplicate: models.DuplicateUpdate, want: &previous.ID, wantStatus: models.ImportStatusUpdated},
+		{name: "same UID is never created twice", previous: previous, onDuplicate: models.DuplicateCreate, want: &previous.ID, wantStatus: models.ImportStatusSkipped},
+		{name: "same UID wins over the name", previous: previous, sameName: []models.Birthday{birthday}, onDuplicate: models.DuplicateUpdate, want: &previous.ID, wantStatus: models.ImportStatusUpdated},
+		{name: "new", onDuplicate: models.DuplicateSkip, wantStatus: models.ImportStatusCreated},
+		{name: "same name is skipped", sameName: []models.Birthday{birthday}, onDuplicate: models.DuplicateSkip, want: &birthday.ID, wantStatus: models.ImportStatusSkipped},
+		{name: "same name is updated", sameName: []models.Birthday{birthday}, onDuplicate: models.DuplicateUpdate, want: &birthday.ID, wantStatus: models.ImportStatusUpdated},
+		{name: "same name is created again", sameName: []models.Birthday{birthday}, onDuplicate: models.DuplicateCreate, wantStatus: models.ImportStatusCreated},
+		{name: "record without event type is a birthday", sameName: []models.Birthday{legacy}, onDuplicate: models.DuplicateUpdate, want: &legacy.ID, wantStatus: models.ImportStatusUpdated},
+		{name: "same name of another type", sameName: []models.Birthday{memorial}, onDuplicate: models.DuplicateUpdate, wantStatus: models.ImportStatusCreated},
+		{name: "same name and type", sameName: []models.Birthday{birthday, memorial}, eventType: models.EventTypeMemorial, onDuplicate: models.DuplicateSkip, want: &memorial.ID, wantStatus: models.ImportStatusSkipped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, status := importMatch(tt.previous, tt.sameName, tt.eventType, tt.onDuplicate)
+			if status != tt.wantStatus {
+				t.Errorf("importMatch() status = %s, want %s", status, tt.wantStatus)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && got.ID != *tt.want) {
+				t.Errorf("importMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeImportRequest(t *testing.T) {
+	birthday := &models.Birthday{
+		Name:       "Jane Doe",
+		BirthMonth: 4,
+		BirthDay:   15,
+		EventType:  models.EventTypeBirthday,
+		Notes:      "Likes tea",
+		Tags:       []models.Tag{{Name: "close"}},
+	}
+
+	merged := mergeImportRequest(birthday, &models.CreateBirthdayRequest{
+		Name:      "Jane Doe",
+		BirthDate: "1990-04-15",
+		Notes:     " ",
+		Tags:      []string{"book club"},
+	})
+
+	if merged.BirthDate != "1990-04-15" {
+		t.Errorf("mergeImportRequest() birth date = %q, want the imported one", merged.BirthDate)
+	}
+	if merged.Notes != "Likes tea" {
+		t.Errorf("mergeImportRequest() notes = %q, want the existing ones", merged.Notes)
+	}
+	if merged.EventType != models.EventTypeBirthday {
+		t.Errorf("mergeImportRequest() event type = %q, want %q", merged.EventType, models.EventTypeBirthday)
+	}
+	if want := []string{"close", "book club"}; !reflect.DeepEqual(merged.Tags, want) {
+		t.Errorf("mergeImportRequest() tags = %q, want %q", merged.Tags, want)
+	}
+}
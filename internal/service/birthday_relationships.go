@@ -0,0 +1,75 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidRelationship  = errors.New("invalid relationship")
+	ErrRelationshipExists   = errors.New("relationship already exists")
+	ErrRelationshipNotFound = errors.New("relationship not found")
+)
+
+// GetRelationships returns the relatives of a birthday, ordered by name
+func (s *BirthdayService) GetRelationships(birthdayID uuid.UUID) ([]models.BirthdayRelationship, error) {
+	return s.relationships.GetByBirthdayID(birthdayID)
+}
+
+// GetRelationshipsByUserID returns both directions of every relationship
+// between the user's birthdays, oldest first
+func (s *BirthdayService) GetRelationshipsByUserID(userID uuid.UUID) ([]models.BirthdayRelationship, error) {
+	return s.relationships.GetByUserID(userID)
+}
+
+// AddRelationship relates birthday to the birthday req.RelatedID of the same
+// user. The reverse direction is added with the inverse type, so that adding
+// Anna as John's child also makes John Anna's parent.
+func (s *BirthdayService) AddRelationship(birthday *models.Birthday, req *models.CreateRelationshipRequest) (*models.BirthdayRelationship, error) {
+	inverseType, ok := models.InverseRelationship(req.Type)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown type %q", ErrInvalidRelationship, req.Type)
+	}
+	if req.RelatedID == birthday.ID {
+		return nil, fmt.Errorf("%w: a birthday cannot be related to itself", ErrInvalidRelationship)
+	}
+
+	related, err := s.repo.GetByID(req.RelatedID)
+	if err != nil || related.UserID != birthday.UserID {
+		return nil, ErrBirthdayNotFound
+	}
+
+	relationship := &models.BirthdayRelationship{
+		BirthdayID: birthday.ID,
+		RelatedID:  related.ID,
+		Type:       req.Type,
+	}
+	inverse := &models.BirthdayRelationship{
+		BirthdayID: related.ID,
+		RelatedID:  birthday.ID,
+		Type:       inverseType,
+	}
+	if err := s.relationships.CreatePair(relationship, inverse); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, ErrRelationshipExists
+		}
+		return nil, err
+	}
+
+	relationship.Related = *related
+	return relationship, nil
+}
+
+// RemoveRelationship removes the relationship with the given ID from birthday,
+// in both directions
+func (s *BirthdayService) RemoveRelationship(birthday *models.Birthday, relationshipID uuid.UUID) error {
+	relationship, err := s.relationships.GetByID(relationshipID)
+	if err != nil || relationship.BirthdayID != birthday.ID {
+		return ErrRelationshipNotFound
+	}
+	return s.relationships.DeletePair(relationship)
+}
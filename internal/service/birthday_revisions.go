@@ -0,0 +1,65 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+)
+
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// record saves a revision of birthday made by actorID. before is the state of
+// the birthday before the change; nil reports every field as new.
+func (s *BirthdayService) record(actorID uuid.UUID, action string, birthday *models.Birthday, before *models.BirthdaySnapshot) error {
+	return s.recordRevision(&models.BirthdayRevision{
+		BirthdayID: birthday.ID,
+		ActorID:    actorID,
+		Action:     action,
+	}, birthday, before)
+}
+
+// recordRevision fills in the snapshot and changes of revision from birthday
+// and saves it. Updates and reverts that change nothing are not recorded.
+func (s *BirthdayService) recordRevision(revision *models.BirthdayRevision, birthday *models.Birthday, before *models.BirthdaySnapshot) error {
+	revision.Snapshot = birthday.Snapshot()
+	revision.Changes = revision.Snapshot.ChangesFrom(before)
+	if len(revision.Changes) == 0 &&
+		(revision.Action == models.RevisionUpdated || revision.Action == models.RevisionReverted) {
+		return nil
+	}
+	return s.revisions.Create(revision)
+}
+
+// recordTagChange reloads the tags of birthday after they were changed
+// directly and records the change.
+func (s *BirthdayService) recordTagChange(actorID uuid.UUID, birthday *models.Birthday, before *models.BirthdaySnapshot) error {
+	current, err := s.repo.GetByID(birthday.ID)
+	if err != nil {
+		return err
+	}
+	birthday.Tags = current.Tags
+	return s.record(actorID, models.RevisionUpdated, birthday, before)
+}
+
+// GetHistory returns the revisions of a birthday, newest first
+func (s *BirthdayService) GetHistory(birthdayID uuid.UUID) ([]models.BirthdayRevision, error) {
+	return s.revisions.GetByBirthdayID(birthdayID)
+}
+
+// GetRevisionsByUserID returns the revisions of all of the user's birthdays,
+// oldest first.
+func (s *BirthdayService) GetRevisionsByUserID(userID uuid.UUID) ([]models.BirthdayRevision, error) {
+	return s.revisions.GetByUserID(userID)
+}
+
+// Revert restores birthday to the state recorded by one of its revisions,
+// on behalf of actorID. The revert is itself recorded as a new revision.
+func (s *BirthdayService) Revert(actorID uuid.UUID, birthday *models.Birthday, revisionID uuid.UUID) (*models.Birthday, error) {
+	revision, err := s.revisions.GetByID(revisionID)
+	if err != nil || revision.BirthdayID != birthday.ID {
+		return nil, ErrRevisionNotFound
+	}
+
+	return s.update(actorID, models.RevisionReverted, birthday, revision.Snapshot.ToRequest(), &revision.ID)
+}
@@ -1,59 +1,403 @@
 package service
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/config"
 	"github.com/murathanje/birthday_tracking_backend/internal/models"
 	"github.com/murathanje/birthday_tracking_backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidBirthday  = errors.New("invalid birthday")
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrBirthdayNotFound = errors.New("birthday not found")
+	errBulkRolledBack   = errors.New("bulk operation rolled back")
 )
 
 type BirthdayService struct {
-	repo *repository.BirthdayRepository
+	repo           *repository.BirthdayRepository
+	revisions      *repository.RevisionRepository
+	relationships  *repository.RelationshipRepository
+	categories     *CategoryService
+	tags           *TagService
+	trashRetention time.Duration
+}
+
+func NewBirthdayService(repo *repository.BirthdayRepository, revisions *repository.RevisionRepository, relationships *repository.RelationshipRepository, categories *CategoryService, tags *TagService, cfg *config.Config) *BirthdayService {
+	return &BirthdayService{
+		repo:           repo,
+		revisions:      revisions,
+		relationships:  relationships,
+		categories:     categories,
+		tags:           tags,
+		trashRetention: time.Duration(cfg.TrashRetentionDays) * 24 * time.Hour,
+	}
 }
 
-func NewBirthdayService(repo *repository.BirthdayRepository) *BirthdayService {
-	return &BirthdayService{repo: repo}
+// withTx returns a service whose repositories run in tx
+func (s *BirthdayService) withTx(tx *gorm.DB) *BirthdayService {
+	return &BirthdayService{
+		repo:           s.repo.WithTx(tx),
+		revisions:      s.revisions.WithTx(tx),
+		relationships:  s.relationships.WithTx(tx),
+		categories:     s.categories.withTx(tx),
+		tags:           s.tags.withTx(tx),
+		trashRetention: s.trashRetention,
+	}
 }
 
 func (s *BirthdayService) CreateBirthday(userID uuid.UUID, req *models.CreateBirthdayRequest) (*models.Birthday, error) {
-	// Parse birth date (MM-DD format)
-	parts := strings.Split(req.BirthDate, "-")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("invalid birth date format, expected MM-DD")
+	return s.create(userID, &models.Birthday{UserID: userID}, req)
+}
+
+// create applies req to the new birthday and saves it together with its
+// first revision, made by actorID.
+func (s *BirthdayService) create(actorID uuid.UUID, birthday *models.Birthday, req *models.CreateBirthdayRequest) (*models.Birthday, error) {
+	err := s.repo.Transaction(func(tx *gorm.DB) error {
+		txService := s.withTx(tx)
+		if err := txService.applyRequest(birthday, req); err != nil {
+			return err
+		}
+		if err := txService.repo.Create(birthday); err != nil {
+			return err
+		}
+		return txService.record(actorID, models.RevisionCreated, birthday, nil)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	month, err := strconv.Atoi(parts[0])
-	if err != nil || month < 1 || month > 12 {
-		return nil, fmt.Errorf("invalid month")
+	return birthday, nil
+}
+
+// UpdateBirthday replaces the fields of birthday with req on behalf of
+// actorID. Tags and each list of contact details are only replaced when
+// they are non-nil in req.
+func (s *BirthdayService) UpdateBirthday(actorID uuid.UUID, birthday *models.Birthday, req *models.CreateBirthdayRequest) (*models.Birthday, error) {
+	return s.update(actorID, models.RevisionUpdated, birthday, req, nil)
+}
+
+// update applies req to birthday and records the change as a revision with
+// the given action. revertedTo is the revision being restored, if any.
+func (s *BirthdayService) update(actorID uuid.UUID, action string, birthday *models.Birthday, req *models.CreateBirthdayRequest, revertedTo *uuid.UUID) (*models.Birthday, error) {
+	before := birthday.Snapshot()
+	err := s.repo.Transaction(func(tx *gorm.DB) error {
+		txService := s.withTx(tx)
+		if err := txService.applyRequest(birthday, req); err != nil {
+			return err
+		}
+		if err := txService.repo.Update(birthday); err != nil {
+			return err
+		}
+		return txService.recordRevision(&models.BirthdayRevision{
+			BirthdayID: birthday.ID,
+			ActorID:    actorID,
+			Action:     action,
+			RevertedTo: revertedTo,
+		}, birthday, &before)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	day, err := strconv.Atoi(parts[1])
-	if err != nil || day < 1 || day > 31 {
-		return nil, fmt.Errorf("invalid day")
+	return birthday, nil
+}
+
+// PatchBirthday applies an RFC 7386 JSON merge patch to birthday on behalf of
+// actorID. Only the members present in patch are changed; null clears notes,
+// partner name, tags and contact details, while the required fields name,
+// birth_date, event_type and category cannot be removed.
+func (s *BirthdayService) PatchBirthday(actorID uuid.UUID, birthday *models.Birthday, patch map[string]json.RawMessage) (*models.Birthday, error) {
+	req := birthday.ToRequest()
+	if err := applyPatch(req, patch); err != nil {
+		return nil, err
 	}
 
-	daysInMonth := getDaysInMonth(month)
-	if day > daysInMonth {
-		return nil, fmt.Errorf("invalid day for month %d", month)
+	return s.UpdateBirthday(actorID, birthday, req)
+}
+
+// applyPatch overlays the members of a merge patch onto req.
+func applyPatch(req *models.CreateBirthdayRequest, patch map[string]json.RawMessage) error {
+	for field, value := range patch {
+		isNull := string(value) == "null"
+		var err error
+		switch field {
+		case "name":
+			err = unmarshalRequired(field, value, isNull, &req.Name)
+		case "birth_date":
+			err = unmarshalRequired(field, value, isNull, &req.BirthDate)
+		case "event_type":
+			err = unmarshalRequired(field, value, isNull, &req.EventType)
+		case "partner_name":
+			req.PartnerName = ""
+			if !isNull {
+				err = json.Unmarshal(value, &req.PartnerName)
+			}
+		case "category":
+			err = unmarshalRequired(field, value, isNull, &req.Category)
+		case "notes":
+			req.Notes = ""
+			if !isNull {
+				err = json.Unmarshal(value, &req.Notes)
+			}
+		case "tags":
+			req.Tags = []string{}
+			if !isNull {
+				err = json.Unmarshal(value, &req.Tags)
+			}
+		case "phones":
+			req.Phones = []models.PhoneNumber{}
+			if !isNull {
+				err = json.Unmarshal(value, &req.Phones)
+			}
+		case "emails":
+			req.Emails = []models.EmailAddress{}
+			if !isNull {
+				err = json.Unmarshal(value, &req.Emails)
+			}
+		case "addresses":
+			req.Addresses = []models.PostalAddress{}
+			if !isNull {
+				err = json.Unmarshal(value, &req.Addresses)
+			}
+		case "social_handles":
+			req.SocialHandles = []models.SocialHandle{}
+			if !isNull {
+				err = json.Unmarshal(value, &req.SocialHandles)
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", field)
+		}
+		if err != nil {
+			return fmt.Errorf("%w: invalid %s: %w", ErrInvalidBirthday, field, err)
+		}
 	}
+	return nil
+}
 
-	birthday := &models.Birthday{
-		UserID:     userID,
-		Name:       req.Name,
-		BirthMonth: month,
-		BirthDay:   day,
-		Category:   req.Category,
-		Notes:      req.Notes,
+func unmarshalRequired(field string, value json.RawMessage, isNull bool, target *string) error {
+	if isNull {
+		return fmt.Errorf("%s cannot be removed", field)
+	}
+	return json.Unmarshal(value, target)
+}
+
+// ValidateBirthdayRequest checks req without touching the database. It is
+// the validation shared by create, update, patch and imports. Every error it
+// returns wraps ErrInvalidBirthday.
+func ValidateBirthdayRequest(req *models.CreateBirthdayRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > 100 {
+		return fmt.Errorf("%w: name must be between 1 and 100 characters", ErrInvalidBirthday)
+	}
+
+	if _, _, _, err := ParseBirthDate(req.BirthDate); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBirthday, err)
 	}
 
-	if err := s.repo.Create(birthday); err != nil {
+	if req.EventType != "" {
+		if _, ok := models.LookupEventType(req.EventType); !ok {
+			return fmt.Errorf("%w: unknown event_type %q", ErrInvalidBirthday, req.EventType)
+		}
+	}
+
+	if utf8.RuneCountInString(strings.TrimSpace(req.PartnerName)) > 100 {
+		return fmt.Errorf("%w: partner_name must be at most 100 characters", ErrInvalidBirthday)
+	}
+
+	category := strings.TrimSpace(req.Category)
+	if category == "" {
+		return fmt.Errorf("%w: %w", ErrInvalidBirthday, ErrCategoryName)
+	}
+	if utf8.RuneCountInString(category) > 50 {
+		return fmt.Errorf("%w: category must be at most 50 characters", ErrInvalidBirthday)
+	}
+
+	if _, err := NormalizeTags(req.Tags); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBirthday, err)
+	}
+
+	if _, err := NormalizeContact(req); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBirthday, err)
+	}
+
+	return nil
+}
+
+// applyRequest validates req and copies it onto birthday. It is the single
+// validation path shared by create, update and patch. A request without an
+// event type keeps the birthday's type, which is birthday for new records.
+func (s *BirthdayService) applyRequest(birthday *models.Birthday, req *models.CreateBirthdayRequest) error {
+	// Validate everything before anything is created on the user's behalf.
+	if err := ValidateBirthdayRequest(req); err != nil {
+		return err
+	}
+
+	eventType, partnerName, err := resolveEventType(birthday, req)
+	if err != nil {
+		return err
+	}
+
+	year, month, day, _ := ParseBirthDate(req.BirthDate)
+	contact, _ := NormalizeContact(req)
+
+	category, err := s.categories.FindOrCreate(birthday.UserID, req.Category)
+	if err != nil {
+		return err
+	}
+
+	if req.Tags != nil {
+		tags, err := s.tags.FindOrCreate(birthday.UserID, req.Tags)
+		if err != nil {
+			return err
+		}
+		birthday.Tags = tags
+	}
+
+	birthday.Name = strings.TrimSpace(req.Name)
+	birthday.EventType = eventType.Key
+	birthday.PartnerName = partnerName
+	birthday.BirthMonth = month
+	birthday.BirthDay = day
+	birthday.BirthYear = year
+	birthday.CategoryID = &category.ID
+	birthday.Category = category.Name
+	birthday.Notes = req.Notes
+
+	if contact.Phones != nil {
+		birthday.Contact.Phones = contact.Phones
+	}
+	if contact.Emails != nil {
+		birthday.Contact.Emails = contact.Emails
+	}
+	if contact.Addresses != nil {
+		birthday.Contact.Addresses = contact.Addresses
+	}
+	if contact.SocialHandles != nil {
+		birthday.Contact.SocialHandles = contact.SocialHandles
+	}
+	birthday.SearchText = birthday.Contact.SearchText()
+
+	return nil
+}
+
+// resolveEventType returns the event type req gives birthday, keeping the
+// birthday's own type when req has none, and the trimmed partner name, which
+// only event types of two people accept.
+func resolveEventType(birthday *models.Birthday, req *models.CreateBirthdayRequest) (*models.EventType, string, error) {
+	eventType := birthday.Type()
+	if req.EventType != "" {
+		var ok bool
+		if eventType, ok = models.LookupEventType(req.EventType); !ok {
+			return nil, "", fmt.Errorf("%w: unknown event_type %q", ErrInvalidBirthday, req.EventType)
+		}
+	}
+	partnerName := strings.TrimSpace(req.PartnerName)
+	if partnerName != "" && !eventType.Couple {
+		return nil, "", fmt.Errorf("%w: partner_name is only allowed for event types of two people", ErrInvalidBirthday)
+	}
+	return eventType, partnerName, nil
+}
+
+// Bulk runs req.Operations in one transaction, each inside its own savepoint.
+// In all-or-nothing mode a single failure rolls back every operation;
+// otherwise failed operations are skipped and the rest are committed.
+func (s *BirthdayService) Bulk(userID uuid.UUID, req *models.BulkBirthdayRequest) (*models.BulkBirthdayResponse, error) {
+	results := make([]models.BulkBirthdayResult, len(req.Operations))
+	failed := false
+
+	err := s.repo.Transaction(func(tx *gorm.DB) error {
+		txService := s.withTx(tx)
+		for i := range req.Operations {
+			results[i] = models.BulkBirthdayResult{Index: i}
+			err := txService.repo.Transaction(func(savepoint *gorm.DB) error {
+				id, status, err := s.withTx(savepoint).runBulkOperation(userID, &req.Operations[i])
+				results[i].ID = id
+				results[i].Status = status
+				return err
+			})
+			if err != nil {
+				failed = true
+				results[i].Status = models.BulkStatusFailed
+				results[i].Error = err.Error()
+			}
+		}
+
+		if failed && req.AllOrNothing {
+			return errBulkRolledBack
+		}
+		return nil
+	})
+
+	committed := err == nil
+	if err != nil && err != errBulkRolledBack {
 		return nil, err
 	}
 
-	return birthday, nil
+	if !committed {
+		for i := range results {
+			if results[i].Status != models.BulkStatusFailed {
+				results[i].Status = models.BulkStatusRolledBack
+				if req.Operations[i].Op == models.BulkOpCreate {
+					results[i].ID = nil
+				}
+			}
+		}
+	}
+
+	return &models.BulkBirthdayResponse{Committed: committed, Results: results}, nil
+}
+
+func (s *BirthdayService) runBulkOperation(userID uuid.UUID, op *models.BulkBirthdayOperation) (*uuid.UUID, string, error) {
+	switch op.Op {
+	case models.BulkOpCreate:
+		if op.Birthday == nil {
+			return nil, "", fmt.Errorf("birthday is required for create")
+		}
+		birthday, err := s.CreateBirthday(userID, op.Birthday)
+		if err != nil {
+			return nil, "", err
+		}
+		return &birthday.ID, models.BulkStatusCreated, nil
+
+	case models.BulkOpUpdate, models.BulkOpDelete:
+		if op.ID == nil {
+			return nil, "", fmt.Errorf("id is required for %s", op.Op)
+		}
+		birthday, err := s.repo.GetByID(*op.ID)
+		if err != nil || birthday.UserID != userID {
+			return op.ID, "", ErrBirthdayNotFound
+		}
+
+		if op.Op == models.BulkOpDelete {
+			if err := s.Delete(userID, birthday); err != nil {
+				return op.ID, "", err
+			}
+			return op.ID, models.BulkStatusDeleted, nil
+		}
+
+		if op.Birthday == nil {
+			return op.ID, "", fmt.Errorf("birthday is required for update")
+		}
+		if _, err := s.UpdateBirthday(userID, birthday, op.Birthday); err != nil {
+			return op.ID, "", err
+		}
+		return op.ID, models.BulkStatusUpdated, nil
+
+	default:
+		return nil, "", fmt.Errorf("unknown op %q, expected create, update or delete", op.Op)
+	}
 }
 
 func (s *BirthdayService) GetByID(id uuid.UUID) (*models.Birthday, error) {
@@ -64,16 +408,328 @@ func (s *BirthdayService) GetByUserID(userID uuid.UUID) ([]models.Birthday, erro
 	return s.repo.GetByUserID(userID)
 }
 
-func (s *BirthdayService) Update(birthday *models.Birthday) error {
-	return s.repo.Update(birthday)
+// ListBirthdays returns one page of the user's birthdays matching query,
+// together with the cursor of the next page (empty on the last page).
+func (s *BirthdayService) ListBirthdays(userID uuid.UUID, cal models.Calendar, query *models.ListBirthdaysQuery) ([]models.Birthday, string, error) {
+	filter := repository.BirthdayFilter{
+		UserID:     userID,
+		EventType:  query.Type,
+		Category:   query.Category,
+		Month:      query.Month,
+		NamePrefix: query.Name,
+		Search:     strings.TrimSpace(query.Q),
+		MatchAll:   query.TagMode == "all",
+		Sort:       query.Sort,
+		Calendar:   cal,
+		Limit:      query.Limit,
+	}
+	if filter.Sort == "" {
+		filter.Sort = repository.SortNextOccurrence
+	}
+	if filter.Limit == 0 {
+		filter.Limit = DefaultPageSize
+	}
+
+	if len(query.Tags) > 0 {
+		// Accept both repeated tags parameters and comma-separated lists.
+		tags, err := NormalizeTags(strings.Split(strings.Join(query.Tags, ","), ","))
+		if err != nil {
+			return nil, "", err
+		}
+		filter.Tags = tags
+	}
+
+	if query.Cursor != "" {
+		after, err := decodeCursor(query.Cursor, filter.Sort)
+		if err != nil {
+			return nil, "", err
+		}
+		filter.After = after
+	}
+
+	// Fetch one extra row to learn whether another page follows.
+	filter.Limit++
+	birthdays, err := s.repo.List(filter)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(birthdays) < filter.Limit {
+		return birthdays, "", nil
+	}
+
+	birthdays = birthdays[:len(birthdays)-1]
+	last := &birthdays[len(birthdays)-1]
+	cursor := repository.BirthdayCursor{ID: last.ID}
+	switch filter.Sort {
+	case repository.SortName, repository.SortNameDesc:
+		cursor.Name = last.Name
+	case repository.SortCreatedAt, repository.SortCreatedAtDesc:
+		cursor.CreatedAt = last.CreatedAt
+	default:
+		cursor.Key = repository.OccurrenceKey(last, cal)
+		cursor.Name = last.Name
+	}
+
+	next, err := encodeCursor(&cursor, filter.Sort)
+	if err != nil {
+		return nil, "", err
+	}
+	return birthdays, next, nil
+}
+
+// pageCursor is the opaque cursor handed out to clients. It records the sort
+// order so a cursor cannot be replayed against a different ordering.
+type pageCursor struct {
+	Sort string `json:"s"`
+	repository.BirthdayCursor
+}
+
+func encodeCursor(cursor *repository.BirthdayCursor, sort string) (string, error) {
+	data, err := json.Marshal(pageCursor{Sort: sort, BirthdayCursor: *cursor})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(value, sort string) (*repository.BirthdayCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor pageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.Sort != sort || cursor.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor.BirthdayCursor, nil
+}
+
+// GetUpcoming returns the user's events of eventType (every type when empty)
+// observed within the next days days (today included), ordered by next
+// occurrence. cal is the user's calendar as returned by User.Calendar.
+func (s *BirthdayService) GetUpcoming(userID uuid.UUID, cal models.Calendar, eventType string, days int) ([]*models.UpcomingBirthdayResponse, error) {
+	if days < 1 || days > MaxUpcomingDays {
+		return nil, fmt.Errorf("days must be between 1 and %d", MaxUpcomingDays)
+	}
+
+	birthdays, err := s.repo.GetByUserIDAndMonths(userID, eventType, monthsInWindow(cal.Today, days))
+	if err != nil {
+		return nil, err
+	}
+
+	upcoming := make([]*models.UpcomingBirthdayResponse, 0, len(birthdays))
+	for i := range birthdays {
+		entry := birthdays[i].ToUpcomingResponse(cal)
+		if entry.DaysUntil < days {
+			upcoming = append(upcoming, entry)
+		}
+	}
+
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		if upcoming[i].DaysUntil != upcoming[j].DaysUntil {
+			return upcoming[i].DaysUntil < upcoming[j].DaysUntil
+		}
+		return upcoming[i].Name < upcoming[j].Name
+	})
+
+	return upcoming, nil
+}
+
+// GetObservances returns the effective observance date of birthday for every
+// year from fromYear to toYear inclusive, following policy.
+func (s *BirthdayService) GetObservances(birthday *models.Birthday, policy models.LeapDayPolicy, fromYear, toYear int) ([]models.ObservanceResponse, error) {
+	if toYear < fromYear || toYear-fromYear >= MaxObservanceYears {
+		return nil, fmt.Errorf("year range must span between 1 and %d years", MaxObservanceYears)
+	}
+
+	observances := make([]models.ObservanceResponse, 0, toYear-fromYear+1)
+	for year := fromYear; year <= toYear; year++ {
+		observance := models.ObservanceResponse{Year: year}
+		if date, ok := birthday.ObservedDate(year, policy); ok {
+			observance.Date = date.Format("2006-01-02")
+			observance.Observed = true
+			observance.TurningYears = birthday.YearsIn(year)
+			if birthday.Type().Key == models.EventTypeBirthday {
+				observance.TurningAge = observance.TurningYears
+			}
+		}
+		observances = append(observances, observance)
+	}
+
+	return observances, nil
 }
 
-func (s *BirthdayService) Delete(id uuid.UUID) error {
-	return s.repo.Delete(id)
+// AddTags attaches the named tags to birthday on behalf of actorID, creating
+// unknown tags.
+func (s *BirthdayService) AddTags(actorID uuid.UUID, birthday *models.Birthday, names []string) error {
+	before := birthday.Snapshot()
+	return s.repo.Transaction(func(tx *gorm.DB) error {
+		txService := s.withTx(tx)
+		tags, err := txService.tags.FindOrCreate(birthday.UserID, names)
+		if err != nil {
+			return err
+		}
+		if err := txService.repo.AddTags(birthday, tags); err != nil {
+			return err
+		}
+		return txService.recordTagChange(actorID, birthday, &before)
+	})
+}
+
+// RemoveTag detaches the named tag from birthday on behalf of actorID. It is
+// not an error if the birthday does not carry the tag.
+func (s *BirthdayService) RemoveTag(actorID uuid.UUID, birthday *models.Birthday, name string) error {
+	before := birthday.Snapshot()
+	for i := range birthday.Tags {
+		if birthday.Tags[i].Name == models.NormalizeTagName(name) {
+			return s.repo.Transaction(func(tx *gorm.DB) error {
+				txService := s.withTx(tx)
+				if err := txService.repo.RemoveTag(birthday, &birthday.Tags[i]); err != nil {
+					return err
+				}
+				return txService.recordTagChange(actorID, birthday, &before)
+			})
+		}
+	}
+	return nil
 }
 
-func (s *BirthdayService) GetByCategory(category string) ([]models.Birthday, error) {
-	return s.repo.GetByCategory(category)
+// Delete moves birthday to the trash on behalf of actorID
+func (s *BirthdayService) Delete(actorID uuid.UUID, birthday *models.Birthday) error {
+	before := birthday.Snapshot()
+	return s.repo.Transaction(func(tx *gorm.DB) error {
+		txService := s.withTx(tx)
+		if err := txService.repo.Delete(birthday.ID); err != nil {
+			return err
+		}
+		return txService.record(actorID, models.RevisionDeleted, birthday, &before)
+	})
+}
+
+// GetCategoryStats lists the user's categories with the number of events of
+// eventType (every type when empty) in each and the next one to be observed
+// in it.
+func (s *BirthdayService) GetCategoryStats(userID uuid.UUID, cal models.Calendar, eventType string) ([]*models.CategoryStatsResponse, error) {
+	categories, err := s.categories.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	birthdays, err := s.repo.GetByUserIDAndType(userID, eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*models.CategoryStatsResponse, len(categories))
+	byID := make(map[uuid.UUID]*models.CategoryStatsResponse, len(categories))
+	for i := range categories {
+		stats[i] = &models.CategoryStatsResponse{CategoryResponse: *categories[i].ToResponse()}
+		byID[categories[i].ID] = stats[i]
+	}
+
+	for i := range birthdays {
+		if birthdays[i].CategoryID == nil {
+			continue
+		}
+		stat, ok := byID[*birthdays[i].CategoryID]
+		if !ok {
+			continue
+		}
+
+		stat.BirthdayCount++
+		upcoming := birthdays[i].ToUpcomingResponse(cal)
+		if stat.NextBirthday == nil ||
+			upcoming.DaysUntil < stat.NextBirthday.DaysUntil ||
+			(upcoming.DaysUntil == stat.NextBirthday.DaysUntil && upcoming.Name < stat.NextBirthday.Name) {
+			stat.NextBirthday = upcoming
+		}
+	}
+
+	return stats, nil
+}
+
+// ParseBirthDate parses a birth date in YYYY-MM-DD or MM-DD format. The
+// returned year is nil when the date has no year component.
+func ParseBirthDate(value string) (*int, int, int, error) {
+	parts := strings.Split(value, "-")
+	var year *int
+	switch len(parts) {
+	case 2:
+	case 3:
+		y, err := strconv.Atoi(parts[0])
+		if err != nil || len(parts[0]) != 4 || y < minBirthYear {
+			return nil, 0, 0, fmt.Errorf("invalid year")
+		}
+		year = &y
+		parts = parts[1:]
+	default:
+		return nil, 0, 0, fmt.Errorf("invalid birth date format, expected YYYY-MM-DD or MM-DD")
+	}
+
+	month, err := strconv.Atoi(parts[0])
+	if err != nil || month < 1 || month > 12 {
+		return nil, 0, 0, fmt.Errorf("invalid month")
+	}
+
+	day, err := strconv.Atoi(parts[1])
+	if err != nil || day < 1 || day > 31 {
+		return nil, 0, 0, fmt.Errorf("invalid day")
+	}
+
+	if day > getDaysInMonth(month) {
+		return nil, 0, 0, fmt.Errorf("invalid day for month %d", month)
+	}
+
+	if year != nil {
+		date := time.Date(*year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		if date.Day() != day {
+			return nil, 0, 0, fmt.Errorf("invalid day for month %d in year %d", month, *year)
+		}
+		// Allow one day of slack so users ahead of UTC can add a baby born today.
+		if date.After(time.Now().UTC().AddDate(0, 0, 1)) {
+			return nil, 0, 0, fmt.Errorf("birth date cannot be in the future")
+		}
+	}
+
+	return year, month, day, nil
+}
+
+// minBirthYear is the earliest birth year accepted by ParseBirthDate.
+const minBirthYear = 1900
+
+const (
+	// DefaultPageSize is the page size used by ListBirthdays when none is given.
+	DefaultPageSize = 50
+	// MaxUpcomingDays is the widest window accepted by GetUpcoming.
+	MaxUpcomingDays = 366
+	// MaxObservanceYears is the widest year range accepted by GetObservances.
+	MaxObservanceYears = 100
+)
+
+// monthsInWindow lists the birth months that can fall within the window of
+// days starting at today, wrapping from December to January. February is
+// included whenever March is, since Feb 29 birthdays may be observed on Mar 1
+// in non-leap years.
+func monthsInWindow(today time.Time, days int) []int {
+	seen := make(map[int]bool)
+	last := today.AddDate(0, 0, days-1)
+	for d := today; !d.After(last); d = d.AddDate(0, 0, 1) {
+		seen[int(d.Month())] = true
+		if len(seen) == 12 {
+			break
+		}
+	}
+	if seen[3] {
+		seen[2] = true
+	}
+
+	months := make([]int, 0, len(seen))
+	for month := range seen {
+		months = append(months, month)
+	}
+	sort.Ints(months)
+	return months
 }
 
 // Helper function to get days in a month
@@ -86,4 +742,4 @@ func getDaysInMonth(month int) int {
 	default:
 		return 31
 	}
-} 
\ No newline at end of file
+}
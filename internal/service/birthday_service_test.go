@@ -0,0 +1,326 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/repository"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func validRequest() *models.CreateBirthdayRequest {
+	return &models.CreateBirthdayRequest{
+		Name:      "Jane Doe",
+		BirthDate: "1990-05-15",
+		Category:  "Friends",
+	}
+}
+
+func TestValidateBirthdayRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(req *models.CreateBirthdayRequest)
+		wantErr string
+	}{
+		{name: "valid", modify: func(req *models.CreateBirthdayRequest) {}},
+		{name: "valid without year", modify: func(req *models.CreateBirthdayRequest) { req.BirthDate = "05-15" }},
+		{name: "valid event type", modify: func(req *models.CreateBirthdayRequest) { req.EventType = models.EventTypeMemorial }},
+		{name: "empty name", modify: func(req *models.CreateBirthdayRequest) { req.Name = "  " }, wantErr: "name must be between 1 and 100 characters"},
+		{name: "name of 100 characters", modify: func(req *models.CreateBirthdayRequest) { req.Name = strings.Repeat("a", 100) }},
+		{name: "name of 100 multibyte characters", modify: func(req *models.CreateBirthdayRequest) { req.Name = strings.Repeat("я", 100) }},
+		{name: "name too long", modify: func(req *models.CreateBirthdayRequest) { req.Name = strings.Repeat("a", 101) }, wantErr: "name must be between 1 and 100 characters"},
+		{name: "partner name of 100 multibyte characters", modify: func(req *models.CreateBirthdayRequest) {
+			req.EventType = models.EventTypeWeddingAnniversary
+			req.PartnerName = strings.Repeat("李", 100)
+		}},
+		{name: "invalid birth date", modify: func(req *models.CreateBirthdayRequest) { req.BirthDate = "15.05.1990" }, wantErr: "invalid birth date format"},
+		{name: "unknown event type", modify: func(req *models.CreateBirthdayRequest) { req.EventType = "holiday" }, wantErr: `unknown event_type "holiday"`},
+		{name: "partner name too long", modify: func(req *models.CreateBirthdayRequest) { req.PartnerName = strings.Repeat("a", 101) }, wantErr: "partner_name must be at most 100 characters"},
+		{name: "empty category", modify: func(req *models.CreateBirthdayRequest) { req.Category = "" }, wantErr: ErrCategoryName.Error()},
+		{name: "category of 50 characters", modify: func(req *models.CreateBirthdayRequest) { req.Category = strings.Repeat("c", 50) + " " }},
+		{name: "category of 50 multibyte characters", modify: func(req *models.CreateBirthdayRequest) { req.Category = strings.Repeat("ü", 50) }},
+		{name: "category too long", modify: func(req *models.CreateBirthdayRequest) { req.Category = strings.Repeat("c", 51) }, wantErr: "category must be at most 50 characters"},
+		{name: "invalid tag", modify: func(req *models.CreateBirthdayRequest) { req.Tags = []string{"a,b"} }, wantErr: ErrInvalidTag.Error()},
+		{name: "invalid phone", modify: func(req *models.CreateBirthdayRequest) {
+			req.Phones = []models.PhoneNumber{{Number: "call me"}}
+		}, wantErr: "phones[0]: invalid phone number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validRequest()
+			tt.modify(req)
+			err := ValidateBirthdayRequest(req)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateBirthdayRequest() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidBirthday) {
+				t.Fatalf("ValidateBirthdayRequest() error = %v, want ErrInvalidBirthday", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateBirthdayRequest() error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApplyRequestRejectsInvalidRequests(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(req *models.CreateBirthdayRequest)
+	}{
+		{name: "invalid birth date", modify: func(req *models.CreateBirthdayRequest) { req.BirthDate = "02-30" }},
+		{name: "empty category", modify: func(req *models.CreateBirthdayRequest) { req.Category = " " }},
+		{name: "unknown event type", modify: func(req *models.CreateBirthdayRequest) { req.EventType = "holiday" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validRequest()
+			tt.modify(req)
+			birthday := &models.Birthday{Name: "Unchanged", BirthMonth: 1, BirthDay: 1}
+
+			// Validation fails before the category and tag lookups, so no
+			// repositories are needed.
+			err := (&BirthdayService{}).applyRequest(birthday, req)
+			if !errors.Is(err, ErrInvalidBirthday) {
+				t.Fatalf("applyRequest() error = %v, want ErrInvalidBirthday", err)
+			}
+			if birthday.Name != "Unchanged" || birthday.BirthMonth != 1 || birthday.BirthDay != 1 {
+				t.Errorf("applyRequest() changed the birthday to %+v", birthday)
+			}
+		})
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	current := func() *models.CreateBirthdayRequest {
+		return &models.CreateBirthdayRequest{
+			Name:        "Jane & John",
+			BirthDate:   "2015-06-20",
+			EventType:   models.EventTypeWeddingAnniversary,
+			PartnerName: "John",
+			Category:    "Family",
+			Notes:       "Church wedding",
+			Tags:        []string{"close"},
+			Phones:      []models.PhoneNumber{{Type: "mobile", Number: "+49 30 1234567"}},
+			Emails:      []models.EmailAddress{},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		patch   string
+		want    func(req *models.CreateBirthdayRequest)
+		wantErr string
+	}{
+		{name: "empty patch", patch: `{}`, want: func(req *models.CreateBirthdayRequest) {}},
+		{name: "set name", patch: `{"name": "Jane"}`, want: func(req *models.CreateBirthdayRequest) { req.Name = "Jane" }},
+		{name: "set birth date and category", patch: `{"birth_date": "06-21", "category": "Friends"}`, want: func(req *models.CreateBirthdayRequest) {
+			req.BirthDate = "06-21"
+			req.Category = "Friends"
+		}},
+		{name: "clear notes", patch: `{"notes": null}`, want: func(req *models.CreateBirthdayRequest) { req.Notes = "" }},
+		{name: "clear partner name", patch: `{"partner_name": null}`, want: func(req *models.CreateBirthdayRequest) { req.PartnerName = "" }},
+		{name: "clear tags", patch: `{"tags": null}`, want: func(req *models.CreateBirthdayRequest) { req.Tags = []string{} }},
+		{name: "replace tags", patch: `{"tags": ["a", "b"]}`, want: func(req *models.CreateBirthdayRequest) { req.Tags = []string{"a", "b"} }},
+		{name: "clear phones", patch: `{"phones": null}`, want: func(req *models.CreateBirthdayRequest) { req.Phones = []models.PhoneNumber{} }},
+		{name: "remove name", patch: `{"name": null}`, wantErr: "invalid name: name cannot be removed"},
+		{name: "remove birth date", patch: `{"birth_date": null}`, wantErr: "invalid birth_date: birth_date cannot be removed"},
+		{name: "remove event type", patch: `{"event_type": null}`, wantErr: "invalid event_type: event_type cannot be removed"},
+		{name: "remove category", patch: `{"category": null}`, wantErr: "invalid category: category cannot be removed"},
+		{name: "wrong type", patch: `{"notes": 5}`, wantErr: "invalid notes"},
+		{name: "unknown field", patch: `{"age": 30}`, wantErr: `invalid age: unknown field "age"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patch map[string]json.RawMessage
+			if err := json.Unmarshal([]byte(tt.patch), &patch); err != nil {
+				t.Fatal(err)
+			}
+
+			req := current()
+			err := applyPatch(req, patch)
+			if tt.wantErr != "" {
+				if !errors.Is(err, ErrInvalidBirthday) {
+					t.Fatalf("applyPatch() error = %v, want ErrInvalidBirthday", err)
+				}
+				if !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("applyPatch() error = %q, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyPatch() error = %v", err)
+			}
+
+			want := current()
+			tt.want(want)
+			if !reflect.DeepEqual(req, want) {
+				t.Errorf("applyPatch() = %+v, want %+v", req, want)
+			}
+		})
+	}
+}
+
+func TestParseBirthDate(t *testing.T) {
+	nextYear := time.Now().UTC().Year() + 1
+
+	tests := []struct {
+		value     string
+		wantYear  *int
+		wantMonth int
+		wantDay   int
+		wantErr   string
+	}{
+		{value: "1990-05-15", wantYear: intPtr(1990), wantMonth: 5, wantDay: 15},
+		{value: "05-15", wantMonth: 5, wantDay: 15},
+		{value: "02-29", wantMonth: 2, wantDay: 29},
+		{value: "2000-02-29", wantYear: intPtr(2000), wantMonth: 2, wantDay: 29},
+		{value: "1900-01-01", wantYear: intPtr(1900), wantMonth: 1, wantDay: 1},
+		{value: "2001-02-29", wantErr: "invalid day for month 2 in year 2001"},
+		{value: "1899-12-31", wantErr: "invalid year"},
+		{value: "90-05-15", wantErr: "invalid year"},
+		{value: "15", wantErr: "invalid birth date format"},
+		{value: "1990-05-15-1", wantErr: "invalid birth date format"},
+		{value: "13-01", wantErr: "invalid month"},
+		{value: "00-10", wantErr: "invalid month"},
+		{value: "05-00", wantErr: "invalid day"},
+		{value: "05-32", wantErr: "invalid day"},
+		{value: "04-31", wantErr: "invalid day for month 4"},
+		{value: fmt.Sprintf("%d-12-31", nextYear), wantErr: "birth date cannot be in the future"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			year, month, day, err := ParseBirthDate(tt.value)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseBirthDate(%q) error = %v, want %q", tt.value, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseBirthDate(%q) error = %v", tt.value, err)
+			}
+			if !reflect.DeepEqual(year, tt.wantYear) || month != tt.wantMonth || day != tt.wantDay {
+				t.Errorf("ParseBirthDate(%q) = %v, %d, %d, want %v, %d, %d", tt.value, year, month, day, tt.wantYear, tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	cursors := []struct {
+		sort   string
+		cursor repository.BirthdayCursor
+	}{
+		{sort: "next", cursor: repository.BirthdayCursor{Key: 515, ID: uuid.New()}},
+		{sort: "-name", cursor: repository.BirthdayCursor{Name: "Jane Doe", ID: uuid.New()}},
+		{sort: "created_at", cursor: repository.BirthdayCursor{CreatedAt: time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC), ID: uuid.New()}},
+	}
+
+	for _, tt := range cursors {
+		t.Run(tt.sort, func(t *testing.T) {
+			value, err := encodeCursor(&tt.cursor, tt.sort)
+			if err != nil {
+				t.Fatalf("encodeCursor() error = %v", err)
+			}
+			cursor, err := decodeCursor(value, tt.sort)
+			if err != nil {
+				t.Fatalf("decodeCursor() error = %v", err)
+			}
+			if !cursor.CreatedAt.Equal(tt.cursor.CreatedAt) || cursor.Key != tt.cursor.Key || cursor.Name != tt.cursor.Name || cursor.ID != tt.cursor.ID {
+				t.Errorf("decodeCursor() = %+v, want %+v", cursor, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorRejectsInvalidCursors(t *testing.T) {
+	valid, err := encodeCursor(&repository.BirthdayCursor{Name: "Jane", ID: uuid.New()}, "name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	withoutID, err := encodeCursor(&repository.BirthdayCursor{Name: "Jane"}, "name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		value string
+		sort  string
+	}{
+		{name: "not base64", value: "not a cursor!", sort: "name"},
+		{name: "not JSON", value: "bm90IGpzb24", sort: "name"},
+		{name: "other sort order", value: valid, sort: "-name"},
+		{name: "missing id", value: withoutID, sort: "name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeCursor(tt.value, tt.sort); err != ErrInvalidCursor {
+				t.Errorf("decodeCursor() error = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
+
+func TestResolveEventType(t *testing.T) {
+	tests := []struct {
+		name            string
+		current         string
+		eventType       string
+		partnerName     string
+		wantType        string
+		wantPartnerName string
+		wantErr         string
+	}{
+		{name: "new record defaults to birthday", wantType: models.EventTypeBirthday},
+		{name: "request sets the type", eventType: models.EventTypeMemorial, wantType: models.EventTypeMemorial},
+		{name: "request without type keeps the current one", current: models.EventTypeWorkAnniversary, wantType: models.EventTypeWorkAnniversary},
+		{name: "request changes the type", current: models.EventTypeAnniversary, eventType: models.EventTypeWeddingAnniversary, wantType: models.EventTypeWeddingAnniversary},
+		{name: "partner of a wedding anniversary", eventType: models.EventTypeWeddingAnniversary, partnerName: " Jane ", wantType: models.EventTypeWeddingAnniversary, wantPartnerName: "Jane"},
+		{name: "partner of the current anniversary", current: models.EventTypeAnniversary, partnerName: "Jane", wantType: models.EventTypeAnniversary, wantPartnerName: "Jane"},
+		{name: "blank partner of a birthday", partnerName: "  ", wantType: models.EventTypeBirthday},
+		{name: "partner of a birthday", partnerName: "Jane", wantErr: "partner_name is only allowed for event types of two people"},
+		{name: "partner of a name day", eventType: models.EventTypeNameDay, partnerName: "Jane", wantErr: "partner_name is only allowed for event types of two people"},
+		{name: "partner kept when changing to a single person type", current: models.EventTypeAnniversary, eventType: models.EventTypeMemorial, partnerName: "Jane", wantErr: "partner_name is only allowed for event types of two people"},
+		{name: "unknown type", eventType: "holiday", wantErr: `unknown event_type "holiday"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			birthday := &models.Birthday{EventType: tt.current}
+			req := &models.CreateBirthdayRequest{EventType: tt.eventType, PartnerName: tt.partnerName}
+			eventType, partnerName, err := resolveEventType(birthday, req)
+			if tt.wantErr != "" {
+				if !errors.Is(err, ErrInvalidBirthday) || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("resolveEventType() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveEventType() error = %v", err)
+			}
+			if eventType.Key != tt.wantType || partnerName != tt.wantPartnerName {
+				t.Errorf("resolveEventType() = %s, %q, want %s, %q", eventType.Key, partnerName, tt.wantType, tt.wantPartnerName)
+			}
+		})
+	}
+}
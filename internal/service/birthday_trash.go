@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// GetTrash returns the user's deleted events of eventType (every type when
+// empty), most recently deleted first
+func (s *BirthdayService) GetTrash(userID uuid.UUID, eventType string) ([]models.Birthday, error) {
+	return s.repo.GetTrashByUserID(userID, eventType)
+}
+
+// GetTrashedByID returns the birthday with the given ID if it is in the trash
+func (s *BirthdayService) GetTrashedByID(id uuid.UUID) (*models.Birthday, error) {
+	birthday, err := s.repo.GetTrashedByID(id)
+	if err != nil {
+		return nil, ErrBirthdayNotFound
+	}
+	return birthday, nil
+}
+
+// TrashRetention is how long deleted birthdays stay in the trash
+func (s *BirthdayService) TrashRetention() time.Duration {
+	return s.trashRetention
+}
+
+// Restore takes birthday out of the trash on behalf of actorID. If its
+// category was deleted while it was in the trash, the category is created
+// again.
+func (s *BirthdayService) Restore(actorID uuid.UUID, birthday *models.Birthday) error {
+	before := birthday.Snapshot()
+	return s.repo.Transaction(func(tx *gorm.DB) error {
+		txService := s.withTx(tx)
+		category, err := txService.categories.FindOrCreate(birthday.UserID, birthday.Category)
+		if err != nil {
+			return err
+		}
+		birthday.CategoryID = &category.ID
+		birthday.Category = category.Name
+		if err := txService.repo.Restore(birthday); err != nil {
+			return err
+		}
+		return txService.record(actorID, models.RevisionRestored, birthday, &before)
+	})
+}
+
+// RunPurge permanently deletes birthdays that have been in the trash longer
+// than the retention period every interval until ctx is done.
+func (s *BirthdayService) RunPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		purged, err := s.repo.PurgeDeletedBefore(time.Now().Add(-s.trashRetention))
+		if err != nil {
+			log.Printf("Failed to purge trashed birthdays: %v", err)
+		} else if purged > 0 {
+			log.Printf("Purged %d trashed birthdays", purged)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+// maxPlanningYear is the latest year accepted for budgets, reports and
+// celebrations
+const maxPlanningYear = 9999
+
+var (
+	ErrInvalidBudget  = errors.New("invalid budget")
+	ErrBudgetExists   = errors.New("a budget for this scope, year and currency already exists")
+	ErrBudgetNotFound = errors.New("budget not found")
+)
+
+type BudgetService struct {
+	repo       *repository.BudgetRepository
+	gifts      *repository.GiftRepository
+	birthdays  *BirthdayService
+	categories *CategoryService
+}
+
+func NewBudgetService(repo *repository.BudgetRepository, gifts *repository.GiftRepository, birthdays *BirthdayService, categories *CategoryService) *BudgetService {
+	return &BudgetService{repo: repo, gifts: gifts, birthdays: birthdays, categories: categories}
+}
+
+func (s *BudgetService) Create(userID uuid.UUID, req *models.CreateBudgetRequest) (*models.Budget, error) {
+	budget := &models.Budget{UserID: userID}
+	if err := s.applyRequest(budget, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(budget); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, ErrBudgetExists
+		}
+		return nil, err
+	}
+	return budget, nil
+}
+
+func (s *BudgetService) GetByID(id uuid.UUID) (*models.Budget, error) {
+	budget, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrBudgetNotFound
+	}
+	return budget, nil
+}
+
+// GetByUserID returns the user's budgets for year, or for every year when
+// year is 0
+func (s *BudgetService) GetByUserID(userID uuid.UUID, year int) ([]models.Budget, error) {
+	return s.repo.GetByUserID(userID, year)
+}
+
+func (s *BudgetService) Update(budget *models.Budget, req *models.CreateBudgetRequest) error {
+	if err := s.applyRequest(budget, req); err != nil {
+		return err
+	}
+	if err := s.repo.Update(budget); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrBudgetExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *BudgetService) Delete(id uuid.UUID) error {
+	return s.repo.Delete(id)
+}
+
+// applyRequest validates req and copies it to budget. The category or
+// birthday must belong to the budget's owner.
+func (s *BudgetService) applyRequest(budget *models.Budget, req *models.CreateBudgetRequest) error {
+	if req.Year < minBirthYear || req.Year > maxPlanningYear {
+		return fmt.Errorf("%w: year must be between %d and %d", ErrInvalidBudget, minBirthYear, maxPlanningYear)
+	}
+	if req.CategoryID != nil && req.BirthdayID != nil {
+		return fmt.Errorf("%w: a budget is either for a category or for a birthday, not both", ErrInvalidBudget)
+	}
+	if req.CategoryID != nil {
+		category, err := s.categories.GetByID(*req.CategoryID)
+		if err != nil || category.UserID != budget.UserID {
+			return ErrCategoryNotFound
+		}
+	}
+	if req.BirthdayID != nil {
+		birthday, err := s.birthdays.GetByID(*req.BirthdayID)
+		if err != nil || birthday.UserID != budget.UserID {
+			return ErrBirthdayNotFound
+		}
+	}
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	if !isCurrencyCode(currency) {
+		return fmt.Errorf("%w: currency must be an ISO 4217 code such as EUR", ErrInvalidBudget)
+	}
+
+	budget.Year = req.Year
+	budget.CategoryID = req.CategoryID
+	budget.BirthdayID = req.BirthdayID
+	budget.Amount = req.Amount
+	budget.Currency = currency
+	return nil
+}
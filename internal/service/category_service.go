@@ -0,0 +1,152 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCategoryExists   = errors.New("a category with this name already exists, merge the categories instead")
+	ErrCategoryInUse    = errors.New("category still has birthdays, merge it into another category instead")
+	ErrCategoryName     = errors.New("category name must not be empty")
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrMergeIntoItself  = errors.New("cannot merge a category into itself")
+)
+
+type CategoryService struct {
+	repo *repository.CategoryRepository
+}
+
+func NewCategoryService(repo *repository.CategoryRepository) *CategoryService {
+	return &CategoryService{repo: repo}
+}
+
+// withTx returns a service whose repository runs in tx
+func (s *CategoryService) withTx(tx *gorm.DB) *CategoryService {
+	return &CategoryService{repo: s.repo.WithTx(tx)}
+}
+
+func (s *CategoryService) CreateCategory(userID uuid.UUID, req *models.CreateCategoryRequest) (*models.Category, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, ErrCategoryName
+	}
+
+	normalized := models.NormalizeCategoryName(name)
+	if existing, err := s.repo.GetByNormalizedName(userID, normalized); err == nil && existing != nil {
+		return nil, ErrCategoryExists
+	}
+
+	category := &models.Category{
+		UserID:         userID,
+		Name:           name,
+		NormalizedName: normalized,
+		Color:          req.Color,
+		Icon:           req.Icon,
+		SortOrder:      req.SortOrder,
+	}
+
+	if err := s.repo.Create(category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (s *CategoryService) GetByID(id uuid.UUID) (*models.Category, error) {
+	return s.repo.GetByID(id)
+}
+
+func (s *CategoryService) GetByUserID(userID uuid.UUID) ([]models.Category, error) {
+	return s.repo.GetByUserID(userID)
+}
+
+// UpdateCategory renames and restyles category. Birthdays in the category
+// pick up the new name in the same transaction.
+func (s *CategoryService) UpdateCategory(category *models.Category, req *models.CreateCategoryRequest) (*models.Category, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, ErrCategoryName
+	}
+
+	normalized := models.NormalizeCategoryName(name)
+	if normalized != category.NormalizedName {
+		if existing, err := s.repo.GetByNormalizedName(category.UserID, normalized); err == nil && existing != nil {
+			return nil, ErrCategoryExists
+		}
+	}
+
+	category.Name = name
+	category.NormalizedName = normalized
+	category.Color = req.Color
+	category.Icon = req.Icon
+	category.SortOrder = req.SortOrder
+
+	if err := s.repo.Update(category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// DeleteCategory deletes an empty category.
+func (s *CategoryService) DeleteCategory(category *models.Category) error {
+	count, err := s.repo.CountBirthdays(category.ID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrCategoryInUse
+	}
+	return s.repo.Delete(category.ID)
+}
+
+// MergeCategories moves the birthdays and budgets of every source category
+// into target and deletes the sources. All sources must belong to target's owner.
+func (s *CategoryService) MergeCategories(target *models.Category, sourceIDs []uuid.UUID) error {
+	for _, id := range sourceIDs {
+		if id == target.ID {
+			return ErrMergeIntoItself
+		}
+		source, err := s.repo.GetByID(id)
+		if err != nil || source.UserID != target.UserID {
+			return ErrCategoryNotFound
+		}
+	}
+	return s.repo.Merge(target, sourceIDs)
+}
+
+// FindOrCreate returns the user's category matching name case-insensitively,
+// creating it when it does not exist yet.
+func (s *CategoryService) FindOrCreate(userID uuid.UUID, name string) (*models.Category, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrCategoryName
+	}
+
+	normalized := models.NormalizeCategoryName(name)
+	if category, err := s.repo.GetByNormalizedName(userID, normalized); err == nil {
+		return category, nil
+	}
+
+	category := &models.Category{
+		UserID:         userID,
+		Name:           name,
+		NormalizedName: normalized,
+	}
+	created, err := s.repo.CreateIfNotExists(category)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		// Another request created it concurrently.
+		return s.repo.GetByNormalizedName(userID, normalized)
+	}
+
+	return category, nil
+}
@@ -0,0 +1,346 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/config"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/repository"
+)
+
+// maxCelebrationOffsetDays is how far a celebration may be moved from the
+// birthday into a neighbouring year, e.g. a party in late December for a
+// birthday on January 2
+const maxCelebrationOffsetDays = 31
+
+var (
+	ErrInvalidCelebration  = errors.New("invalid celebration")
+	ErrCelebrationNotFound = errors.New("celebration not found")
+	ErrTaskNotFound        = errors.New("task not found")
+	ErrGuestNotFound       = errors.New("guest not found")
+	ErrInvalidRSVPLink     = errors.New("invalid RSVP link")
+)
+
+type CelebrationService struct {
+	repo      *repository.CelebrationRepository
+	birthdays *BirthdayService
+	secret    []byte
+}
+
+func NewCelebrationService(repo *repository.CelebrationRepository, birthdays *BirthdayService, cfg *config.Config) *CelebrationService {
+	return &CelebrationService{repo: repo, birthdays: birthdays, secret: []byte(cfg.JWTSecret)}
+}
+
+// Create plans a celebration of birthday. cal supplies the leap-day policy for
+// the default date.
+func (s *CelebrationService) Create(birthday *models.Birthday, cal models.Calendar, req *models.CreateCelebrationRequest) (*models.Celebration, error) {
+	celebration := &models.Celebration{BirthdayID: birthday.ID}
+	if err := applyCelebrationRequest(celebration, birthday, cal, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(celebration); err != nil {
+		return nil, err
+	}
+	return s.GetByID(celebration.ID)
+}
+
+// GetByID returns a celebration with its tasks and guests
+func (s *CelebrationService) GetByID(id uuid.UUID) (*models.Celebration, error) {
+	celebration, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrCelebrationNotFound
+	}
+	return celebration, nil
+}
+
+// GetByBirthday returns the celebrations of birthday, by date
+func (s *CelebrationService) GetByBirthday(birthday *models.Birthday) ([]*models.CelebrationResponse, error) {
+	celebrations, err := s.repo.GetByBirthdayID(birthday.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*models.CelebrationResponse, len(celebrations))
+	for i := range celebrations {
+		response[i] = celebrations[i].ToResponse(birthday)
+	}
+	return response, nil
+}
+
+// GetByUserID returns the celebrations of all of the user's birthdays, by
+// date: those on or after from, or all of them when from is nil.
+func (s *CelebrationService) GetByUserID(userID uuid.UUID, from *time.Time) ([]*models.CelebrationResponse, error) {
+	birthdays, err := s.birthdays.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*models.Birthday, len(birthdays))
+	for i := range birthdays {
+		byID[birthdays[i].ID] = &birthdays[i]
+	}
+
+	var date string
+	if from != nil {
+		date = from.Format("2006-01-02")
+	}
+	celebrations, err := s.repo.GetByUserID(userID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	response := []*models.CelebrationResponse{}
+	for i := range celebrations {
+		if birthday := byID[celebrations[i].BirthdayID]; birthday != nil {
+			response = append(response, celebrations[i].ToResponse(birthday))
+		}
+	}
+	return response, nil
+}
+
+func (s *CelebrationService) Update(celebration *models.Celebration, birthday *models.Birthday, cal models.Calendar, req *models.CreateCelebrationRequest) error {
+	if err := applyCelebrationRequest(celebration, birthday, cal, req); err != nil {
+		return err
+	}
+	return s.repo.Update(celebration)
+}
+
+func (s *CelebrationService) Delete(id uuid.UUID) error {
+	return s.repo.Delete(id)
+}
+
+// AddTask adds a task to the checklist of celebration
+func (s *CelebrationService) AddTask(celebration *models.Celebration, req *models.CreateCelebrationTaskRequest) (*models.CelebrationTask, error) {
+	task := &models.CelebrationTask{CelebrationID: celebration.ID}
+	if err := applyTaskRequest(task, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateTask(task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// GetTask returns a task of celebration
+func (s *CelebrationService) GetTask(celebration *models.Celebration, id uuid.UUID) (*models.CelebrationTask, error) {
+	task, err := s.repo.GetTaskByID(id)
+	if err != nil || task.CelebrationID != celebration.ID {
+		return nil, ErrTaskNotFound
+	}
+	return task, nil
+}
+
+func (s *CelebrationService) UpdateTask(task *models.CelebrationTask, req *models.CreateCelebrationTaskRequest) error {
+	if err := applyTaskRequest(task, req); err != nil {
+		return err
+	}
+	return s.repo.UpdateTask(task)
+}
+
+func (s *CelebrationService) DeleteTask(id uuid.UUID) error {
+	return s.repo.DeleteTask(id)
+}
+
+// AddGuest adds a guest to the guest list of celebration
+func (s *CelebrationService) AddGuest(celebration *models.Celebration, req *models.CreateCelebrationGuestRequest) (*models.CelebrationGuest, error) {
+	guest := &models.CelebrationGuest{CelebrationID: celebration.ID, RSVPStatus: models.RSVPPending}
+	if err := applyGuestRequest(guest, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateGuest(guest); err != nil {
+		return nil, err
+	}
+	return guest, nil
+}
+
+// GetGuest returns a guest of celebration
+func (s *CelebrationService) GetGuest(celebration *models.Celebration, id uuid.UUID) (*models.CelebrationGuest, error) {
+	guest, err := s.repo.GetGuestByID(id)
+	if err != nil || guest.CelebrationID != celebration.ID {
+		return nil, ErrGuestNotFound
+	}
+	return guest, nil
+}
+
+func (s *CelebrationService) UpdateGuest(guest *models.CelebrationGuest, req *models.CreateCelebrationGuestRequest) error {
+	if err := applyGuestRequest(guest, req); err != nil {
+		return err
+	}
+	return s.repo.UpdateGuest(guest)
+}
+
+func (s *CelebrationService) DeleteGuest(id uuid.UUID) error {
+	return s.repo.DeleteGuest(id)
+}
+
+// RSVPToken returns the token of the public RSVP link of a guest: the guest
+// ID and its signature. The link stays valid until the guest is removed.
+func (s *CelebrationService) RSVPToken(guestID uuid.UUID) string {
+	return guestID.String() + "." + s.signRSVP(guestID)
+}
+
+// GetInvitation checks an RSVP token and returns the invitation it grants
+// access to. Invitations to celebrations of birthdays in the trash are not
+// found.
+func (s *CelebrationService) GetInvitation(token string) (*models.RSVPResponse, error) {
+	guest, celebration, birthday, err := s.verifyRSVP(token)
+	if err != nil {
+		return nil, err
+	}
+	return invitation(guest, celebration, birthday), nil
+}
+
+// Respond records a guest's answer given through an RSVP link
+func (s *CelebrationService) Respond(token string, req *models.RSVPRequest) (*models.RSVPResponse, error) {
+	guest, celebration, birthday, err := s.verifyRSVP(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Status == models.RSVPPending || !slices.Contains(models.RSVPStatuses, req.Status) {
+		return nil, fmt.Errorf("%w: status must be accepted, declined or maybe", ErrInvalidCelebration)
+	}
+	message := strings.TrimSpace(req.Message)
+	if utf8.RuneCountInString(message) > 1000 {
+		return nil, fmt.Errorf("%w: message must be at most 1000 characters", ErrInvalidCelebration)
+	}
+
+	now := time.Now()
+	guest.RSVPStatus = req.Status
+	guest.RSVPMessage = message
+	guest.RespondedAt = &now
+	if err := s.repo.UpdateGuest(guest); err != nil {
+		return nil, err
+	}
+	return invitation(guest, celebration, birthday), nil
+}
+
+func (s *CelebrationService) verifyRSVP(token string) (*models.CelebrationGuest, *models.Celebration, *models.Birthday, error) {
+	id, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, nil, nil, ErrInvalidRSVPLink
+	}
+	guestID, err := uuid.Parse(id)
+	if err != nil || !hmac.Equal([]byte(signature), []byte(s.signRSVP(guestID))) {
+		return nil, nil, nil, ErrInvalidRSVPLink
+	}
+
+	guest, err := s.repo.GetGuestByID(guestID)
+	if err != nil {
+		return nil, nil, nil, ErrGuestNotFound
+	}
+	celebration, err := s.GetByID(guest.CelebrationID)
+	if err != nil {
+		return nil, nil, nil, ErrGuestNotFound
+	}
+	birthday, err := s.birthdays.GetByID(celebration.BirthdayID)
+	if err != nil {
+		return nil, nil, nil, ErrGuestNotFound
+	}
+	return guest, celebration, birthday, nil
+}
+
+func (s *CelebrationService) signRSVP(guestID uuid.UUID) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte("celebration-rsvp:" + guestID.String()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// invitation returns what a guest sees of a celebration: no other guests,
+// tasks or notes
+func invitation(guest *models.CelebrationGuest, celebration *models.Celebration, birthday *models.Birthday) *models.RSVPResponse {
+	response := celebration.ToResponse(birthday)
+	return &models.RSVPResponse{
+		Guest:        guest.Name,
+		Name:         response.Name,
+		EventType:    response.EventType,
+		TurningAge:   response.TurningAge,
+		TurningYears: response.TurningYears,
+		CountLabel:   response.CountLabel,
+		Date:         response.Date,
+		Location:     response.Location,
+		Status:       guest.RSVPStatus,
+		Message:      guest.RSVPMessage,
+		RespondedAt:  guest.RespondedAt,
+	}
+}
+
+// applyCelebrationRequest validates req and copies it to celebration. The
+// date defaults to the birthday's observed date in the year.
+func applyCelebrationRequest(celebration *models.Celebration, birthday *models.Birthday, cal models.Calendar, req *models.CreateCelebrationRequest) error {
+	if req.Year < minBirthYear || req.Year > maxPlanningYear {
+		return fmt.Errorf("%w: year must be between %d and %d", ErrInvalidCelebration, minBirthYear, maxPlanningYear)
+	}
+	if birthday.BirthYear != nil && req.Year < *birthday.BirthYear {
+		return fmt.Errorf("%w: year must not be before the birth year", ErrInvalidCelebration)
+	}
+
+	date, ok := birthday.ObservedDate(req.Year, cal.LeapDayPolicy)
+	if !ok {
+		date, _ = birthday.ObservedDate(req.Year, models.LeapDayMar1)
+	}
+	if req.Date != "" {
+		custom, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			return fmt.Errorf("%w: date must be formatted as YYYY-MM-DD", ErrInvalidCelebration)
+		}
+		offset := custom.Sub(date).Hours() / 24
+		if custom.Year() != req.Year && (offset < -maxCelebrationOffsetDays || offset > maxCelebrationOffsetDays) {
+			return fmt.Errorf("%w: date must be in %d or within %d days of the birthday", ErrInvalidCelebration, req.Year, maxCelebrationOffsetDays)
+		}
+		date = custom
+	}
+
+	location := strings.TrimSpace(req.Location)
+	if utf8.RuneCountInString(location) > 255 {
+		return fmt.Errorf("%w: location must be at most 255 characters", ErrInvalidCelebration)
+	}
+
+	celebration.Year = req.Year
+	celebration.Date = date
+	celebration.Location = location
+	celebration.Notes = strings.TrimSpace(req.Notes)
+	return nil
+}
+
+func applyTaskRequest(task *models.CelebrationTask, req *models.CreateCelebrationTaskRequest) error {
+	title := strings.TrimSpace(req.Title)
+	if title == "" || utf8.RuneCountInString(title) > 200 {
+		return fmt.Errorf("%w: task title must be between 1 and 200 characters", ErrInvalidCelebration)
+	}
+
+	task.Title = title
+	task.Done = req.Done
+	return nil
+}
+
+// applyGuestRequest validates req and copies it to guest. An omitted RSVP
+// status keeps the current one.
+func applyGuestRequest(guest *models.CelebrationGuest, req *models.CreateCelebrationGuestRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > 100 {
+		return fmt.Errorf("%w: guest name must be between 1 and 100 characters", ErrInvalidCelebration)
+	}
+	contact := strings.TrimSpace(req.Contact)
+	if utf8.RuneCountInString(contact) > 255 {
+		return fmt.Errorf("%w: contact must be at most 255 characters", ErrInvalidCelebration)
+	}
+	if req.RSVPStatus != "" && !slices.Contains(models.RSVPStatuses, req.RSVPStatus) {
+		return fmt.Errorf("%w: rsvp_status must be one of %s", ErrInvalidCelebration, strings.Join(models.RSVPStatuses, ", "))
+	}
+
+	guest.Name = name
+	guest.Contact = contact
+	if req.RSVPStatus != "" {
+		guest.RSVPStatus = req.RSVPStatus
+	}
+	return nil
+}
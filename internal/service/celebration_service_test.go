@@ -0,0 +1,56 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+)
+
+func TestApplyCelebrationRequestDate(t *testing.T) {
+	newYear := &models.Birthday{BirthMonth: 1, BirthDay: 2}
+	summer := &models.Birthday{BirthMonth: 7, BirthDay: 15}
+	leapDay := &models.Birthday{BirthMonth: 2, BirthDay: 29}
+
+	tests := []struct {
+		name     string
+		birthday *models.Birthday
+		policy   models.LeapDayPolicy
+		year     int
+		date     string
+		want     string
+		wantErr  bool
+	}{
+		{name: "defaults to the birthday", birthday: summer, year: 2025, want: "2025-07-15"},
+		{name: "leap day follows the policy", birthday: leapDay, policy: models.LeapDayFeb28, year: 2025, want: "2025-02-28"},
+		{name: "skipped leap day falls back to March 1", birthday: leapDay, policy: models.LeapDaySkip, year: 2025, want: "2025-03-01"},
+		{name: "weekend after", birthday: summer, year: 2025, date: "2025-07-19", want: "2025-07-19"},
+		{name: "anywhere in the year", birthday: summer, year: 2025, date: "2025-12-31", want: "2025-12-31"},
+		{name: "end of the previous year", birthday: newYear, year: 2026, date: "2025-12-27", want: "2025-12-27"},
+		{name: "31 days before", birthday: newYear, year: 2026, date: "2025-12-02", want: "2025-12-02"},
+		{name: "32 days before", birthday: newYear, year: 2026, date: "2025-12-01", wantErr: true},
+		{name: "other year", birthday: summer, year: 2025, date: "2026-07-15", wantErr: true},
+		{name: "invalid format", birthday: summer, year: 2025, date: "15.07.2025", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			celebration := &models.Celebration{}
+			cal := models.Calendar{Today: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), LeapDayPolicy: tt.policy}
+			err := applyCelebrationRequest(celebration, tt.birthday, cal, &models.CreateCelebrationRequest{Year: tt.year, Date: tt.date})
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidCelebration) {
+					t.Fatalf("applyCelebrationRequest() error = %v, want ErrInvalidCelebration", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyCelebrationRequest() error = %v", err)
+			}
+			if got := celebration.Date.Format("2006-01-02"); got != tt.want {
+				t.Errorf("applyCelebrationRequest() date = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
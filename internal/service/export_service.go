@@ -0,0 +1,466 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/config"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/repository"
+)
+
+var (
+	ErrExportInProgress    = errors.New("an export is already being built")
+	ErrExportNotFound      = errors.New("export not found")
+	ErrExportExpired       = errors.New("export has expired, request a new one")
+	ErrInvalidDownloadLink = errors.New("download link is invalid or has expired")
+)
+
+// downloadLinkTTL is how long a download link stays valid after it was
+// handed out. Links never outlive the archive.
+const downloadLinkTTL = time.Hour
+
+type ExportService struct {
+	repo         *repository.ExportRepository
+	users        *UserService
+	birthdays    *BirthdayService
+	categories   *CategoryService
+	tags         *TagService
+	feeds        *FeedService
+	photos       *PhotoService
+	households   *HouseholdService
+	gifts        *GiftService
+	budgets      *BudgetService
+	journal      *InteractionService
+	celebrations *CelebrationService
+	dir          string
+	ttl          time.Duration
+	secret       []byte
+
+	// builds tracks the archives being built in the background
+	builds sync.WaitGroup
+}
+
+func NewExportService(repo *repository.ExportRepository, users *UserService, birthdays *BirthdayService, categories *CategoryService, tags *TagService, feeds *FeedService, photos *PhotoService, households *HouseholdService, gifts *GiftService, budgets *BudgetService, journal *InteractionService, celebrations *CelebrationService, cfg *config.Config) *ExportService {
+	return &ExportService{
+		repo:         repo,
+		users:        users,
+		birthdays:    birthdays,
+		categories:   categories,
+		tags:         tags,
+		feeds:        feeds,
+		photos:       photos,
+		households:   households,
+		gifts:        gifts,
+		budgets:      budgets,
+		journal:      journal,
+		celebrations: celebrations,
+		dir:          cfg.ExportDir,
+		ttl:          time.Duration(cfg.ExportTTL) * time.Hour,
+		secret:       []byte(cfg.JWTSecret),
+	}
+}
+
+// RequestExport records a new export and builds its archive in the
+// background. A user can only have one export in progress at a time.
+func (s *ExportService) RequestExport(userID uuid.UUID) (*models.DataExport, error) {
+	export := &models.DataExport{UserID: userID, Status: models.ExportStatusPending}
+	created, err := s.repo.CreateIfNoneInProgress(export)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		return nil, ErrExportInProgress
+	}
+
+	s.builds.Add(1)
+	go func(export models.DataExport) {
+		defer s.builds.Done()
+		s.build(export)
+	}(*export)
+
+	return export, nil
+}
+
+// Wait waits until the archives being built are complete or ctx is done.
+// Exports whose build is cut short stay in progress until FailInterrupted
+// runs at the next start.
+func (s *ExportService) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.builds.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *ExportService) GetByID(id uuid.UUID) (*models.DataExport, error) {
+	export, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrExportNotFound
+	}
+	return export, nil
+}
+
+func (s *ExportService) GetByUserID(userID uuid.UUID) ([]models.DataExport, error) {
+	return s.repo.GetByUserID(userID)
+}
+
+// build writes the archive of export and records the outcome
+func (s *ExportService) build(export models.DataExport) {
+	export.Status = models.ExportStatusRunning
+	if err := s.repo.Update(&export); err != nil {
+		log.Printf("Failed to start export %s: %v", export.ID, err)
+		return
+	}
+
+	path, size, err := s.writeArchiveFile(&export)
+	if err != nil {
+		log.Printf("Failed to build export %s: %v", export.ID, err)
+		export.Status = models.ExportStatusFailed
+		export.Error = "Failed to build the archive"
+	} else {
+		now := time.Now()
+		expiresAt := now.Add(s.ttl)
+		export.Status = models.ExportStatusReady
+		export.FilePath = path
+		export.Size = size
+		export.CompletedAt = &now
+		export.ExpiresAt = &expiresAt
+	}
+
+	if err := s.repo.Update(&export); err != nil {
+		log.Printf("Failed to save export %s: %v", export.ID, err)
+		if path != "" {
+			os.Remove(path)
+		}
+	}
+}
+
+// writeArchiveFile writes the archive to a temporary file and moves it into
+// place once it is complete.
+func (s *ExportService) writeArchiveFile(export *models.DataExport) (string, int64, error) {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return "", 0, err
+	}
+
+	path := filepath.Join(s.dir, export.ID.String()+".zip")
+	tmp, err := os.CreateTemp(s.dir, export.ID.String()+"-*.tmp")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := s.writeArchive(tmp, export.UserID); err != nil {
+		tmp.Close()
+		return "", 0, err
+	}
+	info, err := tmp.Stat()
+	if err != nil {
+		tmp.Close()
+		return "", 0, err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", 0, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", 0, err
+	}
+
+	return path, info.Size(), nil
+}
+
+// archiveFile is a JSON file in an export archive
+type archiveFile struct {
+	name  string
+	value interface{}
+}
+
+// writeArchive writes a ZIP of everything stored for the user: JSON files
+// for the profile and each kind of record, CSV and iCalendar renderings of
+// the birthdays, and their photos named after the birthday IDs.
+func (s *ExportService) writeArchive(w io.Writer, userID uuid.UUID) error {
+	user, err := s.users.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+	cal := user.Calendar(time.Now())
+
+	birthdays, err := s.birthdays.GetByUserID(userID)
+	if err != nil {
+		return err
+	}
+	birthdayResponses := make([]*models.BirthdayResponse, len(birthdays))
+	for i := range birthdays {
+		birthdayResponses[i] = birthdays[i].ToResponse(cal)
+	}
+
+	categories, err := s.categories.GetByUserID(userID)
+	if err != nil {
+		return err
+	}
+	categoryResponses := make([]*models.CategoryResponse, len(categories))
+	for i := range categories {
+		categoryResponses[i] = categories[i].ToResponse()
+	}
+
+	tags, err := s.tags.GetByUserID(userID)
+	if err != nil {
+		return err
+	}
+	if tags == nil {
+		tags = []models.TagResponse{}
+	}
+
+	revisions, err := s.birthdays.GetRevisionsByUserID(userID)
+	if err != nil {
+		return err
+	}
+	revisionResponses := make([]*models.BirthdayRevisionResponse, len(revisions))
+	for i := range revisions {
+		revisionResponses[i] = revisions[i].ToResponse()
+	}
+
+	relationships, err := s.birthdays.GetRelationshipsByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	households, err := s.households.GetByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	gifts, err := s.gifts.GetByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	budgets, err := s.budgets.GetByUserID(userID, 0)
+	if err != nil {
+		return err
+	}
+	budgetResponses := make([]*models.BudgetResponse, len(budgets))
+	for i := range budgets {
+		budgetResponses[i] = budgets[i].ToResponse()
+	}
+
+	interactions, err := s.journal.GetByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	celebrations, err := s.celebrations.GetByUserID(userID, nil)
+	if err != nil {
+		return err
+	}
+
+	files := []archiveFile{
+		{"profile.json", user.ToResponse()},
+		{"birthdays.json", birthdayResponses},
+		{"birthday_revisions.json", revisionResponses},
+		{"birthday_relationships.json", relationships},
+		{"categories.json", categoryResponses},
+		{"tags.json", tags},
+		{"households.json", households},
+		{"gifts.json", gifts},
+		{"budgets.json", budgetResponses},
+		{"interactions.json", interactions},
+		{"celebrations.json", celebrations},
+	}
+	if feed, err := s.feeds.GetFeed(userID); err == nil {
+		files = append(files, archiveFile{"calendar_feed.json", feed.ToResponse()})
+	}
+
+	archive := zip.NewWriter(w)
+	for _, file := range files {
+		f, err := archive.Create(file.name)
+		if err != nil {
+			return err
+		}
+		encoder := json.NewEncoder(f)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.value); err != nil {
+			return err
+		}
+	}
+
+	f, err := archive.Create("birthdays.csv")
+	if err != nil {
+		return err
+	}
+	if err := s.birthdays.ExportCSV(userID, "", f); err != nil {
+		return err
+	}
+
+	f, err = archive.Create("birthdays.ics")
+	if err != nil {
+		return err
+	}
+	if err := birthdayCalendar(birthdays, user.LeapDayPolicy, nil).Encode(f); err != nil {
+		return err
+	}
+
+	for i := range birthdays {
+		if photo := birthdays[i].Photo; photo != nil {
+			if err := s.writePhoto(archive, &birthdays[i], photo); err != nil {
+				return err
+			}
+		}
+	}
+
+	return archive.Close()
+}
+
+// writePhoto adds the birthday's photo to archive as photos/<birthday ID>.<ext>.
+// Photos whose image has gone missing from storage are left out.
+func (s *ExportService) writePhoto(archive *zip.Writer, birthday *models.Birthday, photo *models.BirthdayPhoto) error {
+	r, err := s.photos.Open(photo, false)
+	if errors.Is(err, ErrPhotoNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	f, err := archive.Create("photos/" + birthday.ID.String() + photoExtensions[photo.ContentType])
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	return err
+}
+
+// DownloadLink returns the query string of a signed download link for a
+// ready export and when the link expires.
+func (s *ExportService) DownloadLink(export *models.DataExport, now time.Time) (string, time.Time) {
+	expires := now.Add(downloadLinkTTL).Truncate(time.Second)
+	if export.ExpiresAt != nil && export.ExpiresAt.Before(expires) {
+		expires = export.ExpiresAt.Truncate(time.Second)
+	}
+	unix := strconv.FormatInt(expires.Unix(), 10)
+	return "expires=" + unix + "&signature=" + s.sign(export.ID, unix), expires
+}
+
+// VerifyDownload checks a download link and returns the export it grants
+// access to.
+func (s *ExportService) VerifyDownload(id uuid.UUID, expires, signature string, now time.Time) (*models.DataExport, error) {
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || now.Unix() > unix {
+		return nil, ErrInvalidDownloadLink
+	}
+	if !hmac.Equal([]byte(signature), []byte(s.sign(id, expires))) {
+		return nil, ErrInvalidDownloadLink
+	}
+
+	export, err := s.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if export.Status != models.ExportStatusReady || export.ExpiresAt == nil || now.After(*export.ExpiresAt) {
+		return nil, ErrExportExpired
+	}
+	return export, nil
+}
+
+func (s *ExportService) sign(id uuid.UUID, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte("export-download:" + id.String() + ":" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// FailInterrupted marks exports that were being built when the server
+// stopped as failed. It must run before any export is requested.
+func (s *ExportService) FailInterrupted() error {
+	return s.repo.FailInProgress("The server restarted while the archive was being built")
+}
+
+// RunJanitor deletes expired archives every interval until ctx is done.
+func (s *ExportService) RunJanitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.deleteExpired(time.Now()); err != nil {
+			log.Printf("Failed to delete expired exports: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deleteExpired deletes the archives of exports that expired before now,
+// and archives left behind by exports that no longer exist, e.g. because
+// their user was deleted.
+func (s *ExportService) deleteExpired(now time.Time) error {
+	exports, err := s.repo.GetExpired(now)
+	if err != nil {
+		return err
+	}
+	for i := range exports {
+		export := &exports[i]
+		if err := os.Remove(export.FilePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Printf("Failed to delete archive of export %s: %v", export.ID, err)
+			continue
+		}
+		export.Status = models.ExportStatusExpired
+		export.FilePath = ""
+		if err := s.repo.Update(export); err != nil {
+			return err
+		}
+	}
+
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		id, err := uuid.Parse(strings.TrimSuffix(entry.Name(), ".zip"))
+		if err != nil || entry.IsDir() || filepath.Ext(entry.Name()) != ".zip" {
+			continue
+		}
+		exists, err := s.repo.Exists(id)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			if err := os.Remove(filepath.Join(s.dir, entry.Name())); err != nil {
+				log.Printf("Failed to delete orphaned archive %s: %v", entry.Name(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ArchiveName returns the file name offered when downloading export
+func ArchiveName(export *models.DataExport) string {
+	return fmt.Sprintf("birthday-tracking-export-%s.zip", export.CreatedAt.UTC().Format("2006-01-02"))
+}
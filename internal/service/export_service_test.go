@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestExportServiceWait(t *testing.T) {
+	s := &ExportService{}
+	if err := s.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() without builds error = %v", err)
+	}
+
+	s.builds.Add(1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := s.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("Wait() with a running build error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	done := make(chan error)
+	go func() { done <- s.Wait(context.Background()) }()
+	s.builds.Done()
+	if err := <-done; err != nil {
+		t.Errorf("Wait() after the build finished error = %v", err)
+	}
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/ical"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrFeedExists   = errors.New("a calendar feed already exists, rotate its token instead")
+	ErrFeedNotFound = errors.New("calendar feed not found")
+)
+
+// feedRefreshInterval is how often subscribed calendar apps are asked to poll
+const feedRefreshInterval = 12 * time.Hour
+
+type FeedService struct {
+	repo      *repository.FeedRepository
+	birthdays *repository.BirthdayRepository
+}
+
+func NewFeedService(repo *repository.FeedRepository, birthdays *repository.BirthdayRepository) *FeedService {
+	return &FeedService{
+		repo:      repo,
+		birthdays: birthdays,
+	}
+}
+
+// CreateFeed creates the user's feed and returns it with its secret token,
+// which is not stored and cannot be retrieved later.
+func (s *FeedService) CreateFeed(userID uuid.UUID, req *models.CalendarFeedRequest) (*models.CalendarFeed, string, error) {
+	if _, err := s.repo.GetByUserID(userID); err == nil {
+		return nil, "", ErrFeedExists
+	}
+
+	token, hash, err := newFeedToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	feed := &models.CalendarFeed{
+		UserID:          userID,
+		TokenHash:       hash,
+		ReminderMinutes: req.ReminderMinutes,
+	}
+	if err := s.repo.Create(feed); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, "", ErrFeedExists
+		}
+		return nil, "", err
+	}
+
+	return feed, token, nil
+}
+
+func (s *FeedService) GetFeed(userID uuid.UUID) (*models.CalendarFeed, error) {
+	feed, err := s.repo.GetByUserID(userID)
+	if err != nil {
+		return nil, ErrFeedNotFound
+	}
+	return feed, nil
+}
+
+func (s *FeedService) UpdateFeed(userID uuid.UUID, req *models.CalendarFeedRequest) (*models.CalendarFeed, error) {
+	feed, err := s.GetFeed(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	feed.ReminderMinutes = req.ReminderMinutes
+	if err := s.repo.Update(feed); err != nil {
+		return nil, err
+	}
+
+	return feed, nil
+}
+
+// RotateToken replaces the feed's token, so the old subscription URL stops
+// working, and returns the new token.
+func (s *FeedService) RotateToken(userID uuid.UUID) (*models.CalendarFeed, string, error) {
+	feed, err := s.GetFeed(userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	token, hash, err := newFeedToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	feed.TokenHash = hash
+	if err := s.repo.Update(feed); err != nil {
+		return nil, "", err
+	}
+
+	return feed, token, nil
+}
+
+func (s *FeedService) RevokeFeed(userID uuid.UUID) error {
+	deleted, err := s.repo.DeleteByUserID(userID)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrFeedNotFound
+	}
+	return nil
+}
+
+// GetByToken returns the feed, with its user, that token belongs to
+func (s *FeedService) GetByToken(token string) (*models.CalendarFeed, error) {
+	feed, err := s.repo.GetByTokenHash(hashFeedToken(token))
+	if err != nil {
+		return nil, ErrFeedNotFound
+	}
+	return feed, nil
+}
+
+// Calendar renders every birthday and other event of the feed's user as a
+// yearly recurring all-day event. Feb 29 events recur according to the
+// user's leap-day policy.
+func (s *FeedService) Calendar(feed *models.CalendarFeed) (*ical.Calendar, error) {
+	birthdays, err := s.birthdays.GetByUserID(feed.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	cal := birthdayCalendar(birthdays, feed.User.LeapDayPolicy, feed.ReminderMinutes)
+	cal.RefreshInterval = feedRefreshInterval
+	return cal, nil
+}
+
+// birthdayCalendar renders birthdays and other events as yearly recurring
+// all-day events, worded according to their event type
+func birthdayCalendar(birthdays []models.Birthday, policy models.LeapDayPolicy, reminderMinutes *int) *ical.Calendar {
+	cal := &ical.Calendar{
+		ProdID: "-//Birthday Tracking//Birthdays//EN",
+		Name:   "Birthdays and anniversaries",
+		Events: make([]ical.Event, 0, len(birthdays)),
+	}
+	for i := range birthdays {
+		cal.Events = append(cal.Events, birthdayEvent(&birthdays[i], policy, reminderMinutes))
+	}
+	return cal
+}
+
+func birthdayEvent(birthday *models.Birthday, policy models.LeapDayPolicy, reminderMinutes *int) ical.Event {
+	eventType := birthday.Type()
+	summary := eventType.Title(birthday.DisplayName())
+
+	// Start the series in the birth year when it is known; otherwise pick a
+	// year in which the date exists.
+	year := 1970
+	if birthday.BirthYear != nil {
+		year = *birthday.BirthYear
+	} else if birthday.BirthMonth == 2 && birthday.BirthDay == 29 {
+		year = 1972
+	}
+
+	rrule := "FREQ=YEARLY"
+	if birthday.BirthMonth == 2 && birthday.BirthDay == 29 {
+		switch policy {
+		case models.LeapDayFeb28:
+			// The last day of February: Feb 29 in leap years, Feb 28 otherwise.
+			rrule = "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=-1"
+		case models.LeapDaySkip:
+			// Feb 29 does not exist in other years, so they are skipped.
+			rrule = "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29"
+		default:
+			// The 60th day of the year: Feb 29 in leap years, Mar 1 otherwise.
+			rrule = "FREQ=YEARLY;BYYEARDAY=60"
+		}
+	}
+
+	description := birthday.Notes
+	if birthday.BirthYear != nil && eventType.Counted() {
+		since := eventType.Since(*birthday.BirthYear)
+		if description != "" {
+			since += "\n\n"
+		}
+		description = since + description
+	}
+
+	event := ical.Event{
+		UID:         birthday.ID.String() + "@birthday-tracking",
+		Stamp:       birthday.UpdatedAt,
+		Date:        time.Date(year, time.Month(birthday.BirthMonth), birthday.BirthDay, 0, 0, 0, 0, time.UTC),
+		Summary:     summary,
+		Description: description,
+		Categories:  append([]string{birthday.Category}, birthday.TagNames()...),
+		RRule:       rrule,
+	}
+	if reminderMinutes != nil {
+		event.Alarm = &ical.Alarm{
+			Before:      time.Duration(*reminderMinutes) * time.Minute,
+			Description: summary,
+		}
+	}
+
+	return event
+}
+
+// newFeedToken returns a random URL-safe token and the hash stored for it
+func newFeedToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashFeedToken(token), nil
+}
+
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
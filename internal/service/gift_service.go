@@ -0,0 +1,273 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/repository"
+)
+
+// GiftFilterOpen selects the gifts not given yet in GiftService.GetByBirthday
+const GiftFilterOpen = "open"
+
+var (
+	ErrInvalidGift  = errors.New("invalid gift")
+	ErrGiftNotFound = errors.New("gift not found")
+)
+
+type GiftService struct {
+	repo      *repository.GiftRepository
+	birthdays *BirthdayService
+}
+
+func NewGiftService(repo *repository.GiftRepository, birthdays *BirthdayService) *GiftService {
+	return &GiftService{repo: repo, birthdays: birthdays}
+}
+
+// Create adds a gift for birthday. cal supplies the current year for gifts
+// created as given.
+func (s *GiftService) Create(birthday *models.Birthday, cal models.Calendar, req *models.CreateGiftRequest) (*models.Gift, error) {
+	gift := &models.Gift{BirthdayID: birthday.ID, Status: models.GiftStatusIdea}
+	if err := applyGiftRequest(gift, cal, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(gift); err != nil {
+		return nil, err
+	}
+	return gift, nil
+}
+
+func (s *GiftService) GetByID(id uuid.UUID) (*models.Gift, error) {
+	gift, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrGiftNotFound
+	}
+	return gift, nil
+}
+
+// GetByBirthday returns the gifts for a birthday: open gifts by priority and
+// then oldest first, followed by given gifts, most recently given first.
+// status is empty for all gifts, GiftFilterOpen for those not given yet, or
+// a gift status.
+func (s *GiftService) GetByBirthday(birthdayID uuid.UUID, status string) ([]*models.GiftResponse, error) {
+	if status != "" && status != GiftFilterOpen && models.GiftStatusRank(status) < 0 {
+		return nil, fmt.Errorf("%w: status must be open or one of %s", ErrInvalidGift, strings.Join(models.GiftStatuses, ", "))
+	}
+
+	gifts, err := s.repo.GetByBirthdayIDs([]uuid.UUID{birthdayID})
+	if err != nil {
+		return nil, err
+	}
+
+	response := []*models.GiftResponse{}
+	for _, gift := range giftResponses(gifts) {
+		if status == "" || gift.Status == status || (status == GiftFilterOpen && gift.Status != models.GiftStatusGiven) {
+			response = append(response, gift)
+		}
+	}
+	return response, nil
+}
+
+// GetByUserID returns the gifts for all of the user's birthdays
+func (s *GiftService) GetByUserID(userID uuid.UUID) ([]*models.GiftResponse, error) {
+	gifts, err := s.repo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return giftResponses(gifts), nil
+}
+
+// PreviouslyGivenYear returns the last year a gift with the same title as
+// gift was given to the same person, or nil if there is none or gift itself
+// has been given.
+func (s *GiftService) PreviouslyGivenYear(gift *models.Gift) (*int, error) {
+	if !gift.IsOpen() {
+		return nil, nil
+	}
+	gifts, err := s.repo.GetByBirthdayIDs([]uuid.UUID{gift.BirthdayID})
+	if err != nil {
+		return nil, err
+	}
+	return givenYears(gifts)[giftKey(gift)], nil
+}
+
+// Update replaces the fields of gift with req. An empty status keeps the
+// current one; statuses can only move forward.
+func (s *GiftService) Update(gift *models.Gift, cal models.Calendar, req *models.CreateGiftRequest) error {
+	if err := applyGiftRequest(gift, cal, req); err != nil {
+		return err
+	}
+	return s.repo.Update(gift)
+}
+
+func (s *GiftService) Delete(id uuid.UUID) error {
+	return s.repo.Delete(id)
+}
+
+// GetUpcoming returns the user's events of every type in the next days days
+// that have gifts not given yet, ordered by next occurrence, with those gifts.
+func (s *GiftService) GetUpcoming(userID uuid.UUID, cal models.Calendar, days int) ([]*models.UpcomingGiftsResponse, error) {
+	upcoming, err := s.birthdays.GetUpcoming(userID, cal, "", days)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(upcoming))
+	for i, entry := range upcoming {
+		ids[i] = entry.ID
+	}
+	gifts, err := s.repo.GetByBirthdayIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	open := map[uuid.UUID][]*models.GiftResponse{}
+	for _, gift := range giftResponses(gifts) {
+		if gift.Status != models.GiftStatusGiven {
+			open[gift.BirthdayID] = append(open[gift.BirthdayID], gift)
+		}
+	}
+
+	response := []*models.UpcomingGiftsResponse{}
+	for _, entry := range upcoming {
+		if gifts := open[entry.ID]; len(gifts) > 0 {
+			response = append(response, &models.UpcomingGiftsResponse{Birthday: entry, Gifts: gifts})
+		}
+	}
+	return response, nil
+}
+
+// giftResponses converts gifts to responses, keeping their order and
+// filling in when a gift with the same title was last given to the same
+// person.
+func giftResponses(gifts []models.Gift) []*models.GiftResponse {
+	years := givenYears(gifts)
+	response := make([]*models.GiftResponse, len(gifts))
+	for i := range gifts {
+		var previous *int
+		if gifts[i].IsOpen() {
+			previous = years[giftKey(&gifts[i])]
+		}
+		response[i] = gifts[i].ToResponse(previous)
+	}
+	return response
+}
+
+// giftTitle identifies gifts for the same person with the same title,
+// ignoring case and spacing
+type giftTitle struct {
+	birthdayID uuid.UUID
+	title      string
+}
+
+func giftKey(gift *models.Gift) giftTitle {
+	return giftTitle{gift.BirthdayID, strings.ToLower(strings.Join(strings.Fields(gift.Title), " "))}
+}
+
+// givenYears returns the last year each gift title was given to each person
+func givenYears(gifts []models.Gift) map[giftTitle]*int {
+	years := map[giftTitle]*int{}
+	for i := range gifts {
+		gift := &gifts[i]
+		if gift.IsOpen() || gift.GivenYear == nil {
+			continue
+		}
+		key := giftKey(gift)
+		if last := years[key]; last == nil || *gift.GivenYear > *last {
+			years[key] = gift.GivenYear
+		}
+	}
+	return years
+}
+
+// applyGiftRequest validates req and copies it to gift
+func applyGiftRequest(gift *models.Gift, cal models.Calendar, req *models.CreateGiftRequest) error {
+	title := strings.Join(strings.Fields(req.Title), " ")
+	if title == "" || utf8.RuneCountInString(title) > 200 {
+		return fmt.Errorf("%w: title must be between 1 and 200 characters", ErrInvalidGift)
+	}
+
+	link := strings.TrimSpace(req.URL)
+	if link != "" {
+		parsed, err := url.Parse(link)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || len(link) > 2048 {
+			return fmt.Errorf("%w: url must be an http or https URL of at most 2048 characters", ErrInvalidGift)
+		}
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	if currency != "" && !isCurrencyCode(currency) {
+		return fmt.Errorf("%w: currency must be an ISO 4217 code such as EUR", ErrInvalidGift)
+	}
+	if (req.EstimatedPrice != nil || req.ActualPrice != nil) && currency == "" {
+		return fmt.Errorf("%w: currency is required with estimated_price and actual_price", ErrInvalidGift)
+	}
+
+	priority := strings.ToLower(strings.TrimSpace(req.Priority))
+	if priority == "" {
+		priority = models.GiftPriorityMedium
+	}
+	if !slices.Contains(models.GiftPriorities, priority) {
+		return fmt.Errorf("%w: priority must be one of %s", ErrInvalidGift, strings.Join(models.GiftPriorities, ", "))
+	}
+
+	status := strings.ToLower(strings.TrimSpace(req.Status))
+	if status == "" {
+		status = gift.Status
+	}
+	rank := models.GiftStatusRank(status)
+	if rank < 0 {
+		return fmt.Errorf("%w: status must be one of %s", ErrInvalidGift, strings.Join(models.GiftStatuses, ", "))
+	}
+	if rank < models.GiftStatusRank(gift.Status) {
+		return fmt.Errorf("%w: status cannot go back from %s to %s", ErrInvalidGift, gift.Status, status)
+	}
+
+	if req.ActualPrice != nil && status == models.GiftStatusIdea {
+		return fmt.Errorf("%w: actual_price is only allowed once the gift is purchased", ErrInvalidGift)
+	}
+
+	givenYear := req.GivenYear
+	if status == models.GiftStatusGiven {
+		if givenYear == nil {
+			givenYear = gift.GivenYear
+		}
+		if givenYear == nil {
+			year := cal.Today.Year()
+			givenYear = &year
+		}
+		if *givenYear < minBirthYear || *givenYear > cal.Today.Year() {
+			return fmt.Errorf("%w: given_year must be between %d and %d", ErrInvalidGift, minBirthYear, cal.Today.Year())
+		}
+	} else if givenYear != nil {
+		return fmt.Errorf("%w: given_year is only allowed for given gifts", ErrInvalidGift)
+	}
+
+	gift.Title = title
+	gift.URL = link
+	gift.EstimatedPrice = req.EstimatedPrice
+	gift.ActualPrice = req.ActualPrice
+	gift.Currency = currency
+	gift.Priority = priority
+	gift.Status = status
+	gift.GivenYear = givenYear
+	return nil
+}
+
+func isCurrencyCode(value string) bool {
+	if len(value) != 3 {
+		return false
+	}
+	for i := 0; i < len(value); i++ {
+		if value[i] < 'A' || value[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+)
+
+func TestApplyGiftRequestTitle(t *testing.T) {
+	tests := []struct {
+		name    string
+		title   string
+		want    string
+		wantErr bool
+	}{
+		{name: "whitespace collapsed", title: "  Espresso   machine ", want: "Espresso machine"},
+		{name: "200 characters", title: strings.Repeat("g", 200), want: strings.Repeat("g", 200)},
+		{name: "200 multibyte characters", title: strings.Repeat("礼", 200), want: strings.Repeat("礼", 200)},
+		{name: "empty", title: "   ", wantErr: true},
+		{name: "too long", title: strings.Repeat("g", 201), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gift := &models.Gift{Status: models.GiftStatusIdea}
+			cal := models.Calendar{Today: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)}
+			err := applyGiftRequest(gift, cal, &models.CreateGiftRequest{Title: tt.title})
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidGift) {
+					t.Fatalf("applyGiftRequest() error = %v, want ErrInvalidGift", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyGiftRequest() error = %v", err)
+			}
+			if gift.Title != tt.want {
+				t.Errorf("applyGiftRequest() title = %q, want %q", gift.Title, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/repository"
+)
+
+var (
+	ErrInvalidHousehold  = errors.New("invalid household")
+	ErrHouseholdName     = errors.New("household name must not be empty")
+	ErrHouseholdNotFound = errors.New("household not found")
+	ErrNotAMember        = errors.New("birthday is not a member of this household")
+)
+
+type HouseholdService struct {
+	repo      *repository.HouseholdRepository
+	birthdays *BirthdayService
+}
+
+func NewHouseholdService(repo *repository.HouseholdRepository, birthdays *BirthdayService) *HouseholdService {
+	return &HouseholdService{repo: repo, birthdays: birthdays}
+}
+
+func (s *HouseholdService) Create(userID uuid.UUID, req *models.CreateHouseholdRequest) (*models.Household, error) {
+	household := &models.Household{UserID: userID}
+	if err := applyHouseholdRequest(household, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(household); err != nil {
+		return nil, err
+	}
+	return household, nil
+}
+
+func (s *HouseholdService) GetByID(id uuid.UUID) (*models.Household, error) {
+	household, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrHouseholdNotFound
+	}
+	return household, nil
+}
+
+// GetByUserID returns the user's households with their member counts,
+// ordered by name
+func (s *HouseholdService) GetByUserID(userID uuid.UUID) ([]*models.HouseholdResponse, error) {
+	households, err := s.repo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := s.repo.CountMembers(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*models.HouseholdResponse, len(households))
+	for i := range households {
+		response[i] = households[i].ToResponse(counts[households[i].ID])
+	}
+	return response, nil
+}
+
+// CountMembers returns the number of birthdays in household, not counting
+// birthdays in the trash
+func (s *HouseholdService) CountMembers(household *models.Household) (int, error) {
+	counts, err := s.repo.CountMembers(household.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return counts[household.ID], nil
+}
+
+func (s *HouseholdService) Update(household *models.Household, req *models.CreateHouseholdRequest) error {
+	if err := applyHouseholdRequest(household, req); err != nil {
+		return err
+	}
+	return s.repo.Update(household)
+}
+
+// Delete deletes the household. Its members are kept without a household.
+func (s *HouseholdService) Delete(id uuid.UUID) error {
+	return s.repo.Delete(id)
+}
+
+// GetMembers returns the birthdays in the household, ordered by name
+func (s *HouseholdService) GetMembers(householdID uuid.UUID) ([]models.Birthday, error) {
+	return s.repo.GetMembers(householdID)
+}
+
+// AddMember moves the birthday with the given ID into household. A birthday
+// belongs to at most one household, so it leaves its previous one.
+func (s *HouseholdService) AddMember(household *models.Household, birthdayID uuid.UUID) error {
+	birthday, err := s.birthdays.GetByID(birthdayID)
+	if err != nil || birthday.UserID != household.UserID {
+		return ErrBirthdayNotFound
+	}
+	return s.repo.SetHousehold(birthday.ID, &household.ID)
+}
+
+// RemoveMember takes the birthday with the given ID out of household
+func (s *HouseholdService) RemoveMember(household *models.Household, birthdayID uuid.UUID) error {
+	birthday, err := s.birthdays.GetByID(birthdayID)
+	if err != nil || birthday.UserID != household.UserID {
+		return ErrBirthdayNotFound
+	}
+	if birthday.HouseholdID == nil || *birthday.HouseholdID != household.ID {
+		return ErrNotAMember
+	}
+	return s.repo.SetHousehold(birthday.ID, nil)
+}
+
+// GroupUpcoming groups the user's upcoming birthdays by household. Groups are
+// ordered by their earliest upcoming birthday and keep the order of upcoming
+// within; birthdays without a household form one group without an ID.
+func (s *HouseholdService) GroupUpcoming(userID uuid.UUID, upcoming []*models.UpcomingBirthdayResponse) ([]*models.UpcomingHouseholdResponse, error) {
+	households, err := s.repo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[uuid.UUID]string, len(households))
+	for _, household := range households {
+		names[household.ID] = household.Name
+	}
+
+	groups := []*models.UpcomingHouseholdResponse{}
+	byHousehold := map[uuid.UUID]*models.UpcomingHouseholdResponse{}
+	for _, entry := range upcoming {
+		var key uuid.UUID
+		if entry.HouseholdID != nil {
+			key = *entry.HouseholdID
+		}
+		group, ok := byHousehold[key]
+		if !ok {
+			group = &models.UpcomingHouseholdResponse{
+				HouseholdID:   entry.HouseholdID,
+				HouseholdName: names[key],
+				Birthdays:     []*models.UpcomingBirthdayResponse{},
+			}
+			byHousehold[key] = group
+			groups = append(groups, group)
+		}
+		group.Birthdays = append(group.Birthdays, entry)
+	}
+	return groups, nil
+}
+
+// applyHouseholdRequest validates req and copies it to household
+func applyHouseholdRequest(household *models.Household, req *models.CreateHouseholdRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return fmt.Errorf("%w: %w", ErrInvalidHousehold, ErrHouseholdName)
+	}
+	address, err := NormalizeAddress(req.Address)
+	if err != nil {
+		return fmt.Errorf("%w: address: %w", ErrInvalidHousehold, err)
+	}
+
+	household.Name = name
+	household.Address = address
+	return nil
+}
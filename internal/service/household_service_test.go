@@ -0,0 +1,49 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+)
+
+func TestApplyHouseholdRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     models.CreateHouseholdRequest
+		want    models.Household
+		wantErr string
+	}{
+		{
+			name: "normalized",
+			req:  models.CreateHouseholdRequest{Name: " Doe family ", Address: models.Address{Street: " Main St  1 \n\n", City: " Berlin ", Country: "de"}},
+			want: models.Household{Name: "Doe family", Address: models.Address{Street: "Main St 1", City: "Berlin", Country: "DE"}},
+		},
+		{name: "empty name", req: models.CreateHouseholdRequest{Name: "  "}, wantErr: ErrHouseholdName.Error()},
+		{name: "invalid country", req: models.CreateHouseholdRequest{Name: "Doe family", Address: models.Address{Country: "Germany"}}, wantErr: "address: country must be"},
+		{name: "street too long", req: models.CreateHouseholdRequest{Name: "Doe family", Address: models.Address{Street: strings.Repeat("s", 201)}}, wantErr: "address: street must not exceed 200 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			household := &models.Household{}
+			err := applyHouseholdRequest(household, &tt.req)
+			if tt.wantErr != "" {
+				if !errors.Is(err, ErrInvalidHousehold) {
+					t.Fatalf("applyHouseholdRequest() error = %v, want ErrInvalidHousehold", err)
+				}
+				if !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("applyHouseholdRequest() error = %q, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyHouseholdRequest() error = %v", err)
+			}
+			if household.Name != tt.want.Name || household.Address != tt.want.Address {
+				t.Errorf("applyHouseholdRequest() = %+v, want %+v", household, tt.want)
+			}
+		})
+	}
+}
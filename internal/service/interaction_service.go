@@ -0,0 +1,137 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/repository"
+)
+
+var (
+	ErrInvalidInteraction  = errors.New("invalid interaction")
+	ErrInteractionNotFound = errors.New("interaction not found")
+)
+
+type InteractionService struct {
+	repo      *repository.InteractionRepository
+	gifts     *repository.GiftRepository
+	birthdays *BirthdayService
+}
+
+func NewInteractionService(repo *repository.InteractionRepository, gifts *repository.GiftRepository, birthdays *BirthdayService) *InteractionService {
+	return &InteractionService{repo: repo, gifts: gifts, birthdays: birthdays}
+}
+
+// Create adds an entry to the journal of birthday
+func (s *InteractionService) Create(birthday *models.Birthday, req *models.CreateInteractionRequest) (*models.Interaction, error) {
+	interaction := &models.Interaction{BirthdayID: birthday.ID}
+	if err := s.applyRequest(interaction, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(interaction); err != nil {
+		return nil, err
+	}
+	return interaction, nil
+}
+
+func (s *InteractionService) GetByID(id uuid.UUID) (*models.Interaction, error) {
+	interaction, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrInteractionNotFound
+	}
+	return interaction, nil
+}
+
+// GetByBirthday returns the journal of a birthday, oldest first
+func (s *InteractionService) GetByBirthday(birthdayID uuid.UUID) ([]*models.InteractionResponse, error) {
+	interactions, err := s.repo.GetByBirthdayID(birthdayID)
+	if err != nil {
+		return nil, err
+	}
+	return interactionResponses(interactions), nil
+}
+
+// GetByUserID returns the journals of all of the user's birthdays
+func (s *InteractionService) GetByUserID(userID uuid.UUID) ([]*models.InteractionResponse, error) {
+	interactions, err := s.repo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return interactionResponses(interactions), nil
+}
+
+func (s *InteractionService) Update(interaction *models.Interaction, req *models.CreateInteractionRequest) error {
+	if err := s.applyRequest(interaction, req); err != nil {
+		return err
+	}
+	return s.repo.Update(interaction)
+}
+
+func (s *InteractionService) Delete(id uuid.UUID) error {
+	return s.repo.Delete(id)
+}
+
+// GetTimeline returns the journal of a birthday merged with its revision
+// history, oldest first. Entries at the same time keep system events first.
+func (s *InteractionService) GetTimeline(birthdayID uuid.UUID) ([]*models.TimelineEntry, error) {
+	interactions, err := s.repo.GetByBirthdayID(birthdayID)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := s.birthdays.GetHistory(birthdayID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := make([]*models.TimelineEntry, 0, len(interactions)+len(revisions))
+	for i := len(revisions) - 1; i >= 0; i-- {
+		timeline = append(timeline, revisions[i].TimelineEntry())
+	}
+	for i := range interactions {
+		timeline = append(timeline, interactions[i].TimelineEntry())
+	}
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].OccurredAt.Before(timeline[j].OccurredAt)
+	})
+	return timeline, nil
+}
+
+func interactionResponses(interactions []models.Interaction) []*models.InteractionResponse {
+	response := make([]*models.InteractionResponse, len(interactions))
+	for i := range interactions {
+		response[i] = interactions[i].ToResponse()
+	}
+	return response
+}
+
+// applyRequest validates req and copies it to interaction. A linked gift
+// must belong to the same birthday; an omitted occurred_at keeps the current
+// time of the entry, or is now for new entries.
+func (s *InteractionService) applyRequest(interaction *models.Interaction, req *models.CreateInteractionRequest) error {
+	if !slices.Contains(models.InteractionTypes, req.Type) {
+		return fmt.Errorf("%w: type must be one of %s", ErrInvalidInteraction, strings.Join(models.InteractionTypes, ", "))
+	}
+	if req.GiftID != nil {
+		gift, err := s.gifts.GetByID(*req.GiftID)
+		if err != nil || gift.BirthdayID != interaction.BirthdayID {
+			return fmt.Errorf("%w: gift_id must be a gift of the same birthday", ErrInvalidInteraction)
+		}
+	}
+
+	interaction.Type = req.Type
+	switch {
+	case req.OccurredAt != nil:
+		interaction.OccurredAt = req.OccurredAt.UTC()
+	case interaction.OccurredAt.IsZero():
+		interaction.OccurredAt = time.Now().UTC()
+	}
+	interaction.Text = strings.TrimSpace(req.Text)
+	interaction.GiftID = req.GiftID
+	return nil
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/imaging"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/repository"
+	"github.com/murathanje/birthday_tracking_backend/internal/storage"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPhotoNotFound    = errors.New("photo not found")
+	ErrUnsupportedPhoto = errors.New("photo must be a JPEG, PNG or GIF image")
+	ErrInvalidPhoto     = errors.New("photo could not be decoded")
+	ErrPhotoTooLarge    = errors.New("photo must not exceed 40 megapixels")
+)
+
+const (
+	// photoMaxDimension is the longest side of stored photos; larger uploads
+	// are scaled down
+	photoMaxDimension = 2048
+	// photoThumbnailSize is the side of the square thumbnails
+	photoThumbnailSize = 256
+	// photoJanitorBatch is how many detached photos RunJanitor deletes per pass
+	photoJanitorBatch = 100
+)
+
+// photoExtensions maps the content types of stored photos to file extensions
+var photoExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+type PhotoService struct {
+	repo    *repository.PhotoRepository
+	storage storage.Storage
+}
+
+func NewPhotoService(repo *repository.PhotoRepository, store storage.Storage) *PhotoService {
+	return &PhotoService{
+		repo:    repo,
+		storage: store,
+	}
+}
+
+// Upload attaches the image in data to the birthday, replacing its current
+// photo. The image is re-encoded, which strips its metadata, after applying
+// its EXIF orientation and scaling it down to photoMaxDimension. A square
+// thumbnail is stored alongside it.
+func (s *PhotoService) Upload(birthdayID uuid.UUID, data []byte) (*models.BirthdayPhoto, error) {
+	img, format, err := imaging.Decode(data)
+	switch {
+	case errors.Is(err, imaging.ErrUnsupportedFormat):
+		return nil, ErrUnsupportedPhoto
+	case errors.Is(err, imaging.ErrTooLarge):
+		return nil, ErrPhotoTooLarge
+	case err != nil:
+		return nil, ErrInvalidPhoto
+	}
+
+	full, err := imaging.Encode(imaging.Fit(img, photoMaxDimension), format)
+	if err != nil {
+		return nil, err
+	}
+	thumbnail, err := imaging.Encode(imaging.Thumbnail(img, photoThumbnailSize), format)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.New()
+	ext := photoExtensions[full.ContentType]
+	photo := &models.BirthdayPhoto{
+		ID:           id,
+		BirthdayID:   &birthdayID,
+		Key:          fmt.Sprintf("photos/%s/%s%s", birthdayID, id, ext),
+		ThumbnailKey: fmt.Sprintf("photos/%s/%s-thumb%s", birthdayID, id, ext),
+		ContentType:  full.ContentType,
+		Size:         int64(len(full.Data)),
+		Width:        full.Width,
+		Height:       full.Height,
+	}
+
+	if err := s.storage.Put(photo.Key, full.Data, full.ContentType); err != nil {
+		return nil, err
+	}
+	if err := s.storage.Put(photo.ThumbnailKey, thumbnail.Data, thumbnail.ContentType); err != nil {
+		s.removeObjects(photo)
+		return nil, err
+	}
+
+	var previous *models.BirthdayPhoto
+	err = s.repo.Transaction(func(tx *gorm.DB) error {
+		repo := s.repo.WithTx(tx)
+		previous, _ = repo.GetByBirthdayID(birthdayID)
+		if err := repo.Detach(birthdayID); err != nil {
+			return err
+		}
+		return repo.Create(photo)
+	})
+	if err != nil {
+		s.removeObjects(photo)
+		return nil, err
+	}
+	if previous != nil {
+		s.remove(previous)
+	}
+
+	return photo, nil
+}
+
+// GetByBirthdayID returns the photo attached to the birthday
+func (s *PhotoService) GetByBirthdayID(birthdayID uuid.UUID) (*models.BirthdayPhoto, error) {
+	photo, err := s.repo.GetByBirthdayID(birthdayID)
+	if err != nil {
+		return nil, ErrPhotoNotFound
+	}
+	return photo, nil
+}
+
+// Open opens the stored image of photo, or its thumbnail
+func (s *PhotoService) Open(photo *models.BirthdayPhoto, thumbnail bool) (io.ReadCloser, error) {
+	key := photo.Key
+	if thumbnail {
+		key = photo.ThumbnailKey
+	}
+	r, err := s.storage.Get(key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, ErrPhotoNotFound
+	}
+	return r, err
+}
+
+// Delete removes the photo attached to the birthday
+func (s *PhotoService) Delete(birthdayID uuid.UUID) error {
+	photo, err := s.GetByBirthdayID(birthdayID)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Detach(birthdayID); err != nil {
+		return err
+	}
+	s.remove(photo)
+	return nil
+}
+
+// RunJanitor deletes the objects and records of detached photos every
+// interval: photos that were replaced or deleted while their objects could
+// not be removed, and photos of purged birthdays. It stops when ctx is done.
+func (s *PhotoService) RunJanitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		photos, err := s.repo.GetDetached(photoJanitorBatch)
+		if err != nil {
+			log.Printf("Failed to load detached photos: %v", err)
+		}
+		for i := range photos {
+			s.remove(&photos[i])
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// remove deletes the objects of a detached photo and then its record. Failures
+// are logged; the record is kept so that RunJanitor tries again.
+func (s *PhotoService) remove(photo *models.BirthdayPhoto) {
+	if err := s.removeObjects(photo); err != nil {
+		log.Printf("Failed to delete objects of photo %s: %v", photo.ID, err)
+		return
+	}
+	if err := s.repo.Delete(photo.ID); err != nil {
+		log.Printf("Failed to delete photo %s: %v", photo.ID, err)
+	}
+}
+
+func (s *PhotoService) removeObjects(photo *models.BirthdayPhoto) error {
+	if err := s.storage.Delete(photo.Key); err != nil {
+		return err
+	}
+	return s.storage.Delete(photo.ThumbnailKey)
+}
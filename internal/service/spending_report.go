@@ -0,0 +1,180 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+)
+
+// spendingTally adds up gift spending per currency
+type spendingTally map[string]*models.SpendingAmounts
+
+func (t spendingTally) get(currency string) *models.SpendingAmounts {
+	amounts, ok := t[currency]
+	if !ok {
+		amounts = &models.SpendingAmounts{Currency: currency}
+		t[currency] = amounts
+	}
+	return amounts
+}
+
+func (t spendingTally) addGift(gift *models.Gift) {
+	amounts := t.get(gift.Currency)
+	if gift.EstimatedPrice != nil {
+		amounts.Estimated += *gift.EstimatedPrice
+	}
+	if gift.ActualPrice != nil {
+		amounts.Actual += *gift.ActualPrice
+	}
+}
+
+func (t spendingTally) setBudget(budget *models.Budget) {
+	amount := budget.Amount
+	t.get(budget.Currency).Budget = &amount
+}
+
+// list returns the amounts ordered by currency
+func (t spendingTally) list() []*models.SpendingAmounts {
+	list := make([]*models.SpendingAmounts, 0, len(t))
+	for _, amounts := range t {
+		list = append(list, amounts)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Currency < list[j].Currency })
+	return list
+}
+
+// SpendingReport compares the user's gift budgets for year with the
+// estimated and actual prices of the gifts for the birthdays observed in
+// that year. Given gifts count towards their given_year; gifts not given yet
+// towards the next occurrence of the birthday, relative to cal.Today. Months
+// are those of the occurrences. Amounts are kept per currency.
+func (s *BudgetService) SpendingReport(userID uuid.UUID, cal models.Calendar, year int) (*models.SpendingReportResponse, error) {
+	if year < minBirthYear || year > maxPlanningYear {
+		return nil, fmt.Errorf("%w: year must be between %d and %d", ErrInvalidBudget, minBirthYear, maxPlanningYear)
+	}
+
+	birthdays, err := s.birthdays.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := s.categories.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	gifts, err := s.gifts.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	budgets, err := s.repo.GetByUserID(userID, year)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*models.Birthday, len(birthdays))
+	for i := range birthdays {
+		byID[birthdays[i].ID] = &birthdays[i]
+	}
+
+	totals := spendingTally{}
+	months := map[int]spendingTally{}
+	byCategory := map[uuid.UUID]spendingTally{}
+	byPerson := map[uuid.UUID]spendingTally{}
+	tally := func(tallies map[uuid.UUID]spendingTally, id uuid.UUID) spendingTally {
+		if tallies[id] == nil {
+			tallies[id] = spendingTally{}
+		}
+		return tallies[id]
+	}
+
+	for i := range gifts {
+		gift := &gifts[i]
+		birthday := byID[gift.BirthdayID]
+		if birthday == nil || gift.Currency == "" || (gift.EstimatedPrice == nil && gift.ActualPrice == nil) {
+			continue
+		}
+		date := giftOccasion(birthday, gift, cal)
+		if date.Year() != year {
+			continue
+		}
+
+		month := int(date.Month())
+		if months[month] == nil {
+			months[month] = spendingTally{}
+		}
+		totals.addGift(gift)
+		months[month].addGift(gift)
+		if birthday.CategoryID != nil {
+			tally(byCategory, *birthday.CategoryID).addGift(gift)
+		}
+		tally(byPerson, birthday.ID).addGift(gift)
+	}
+
+	for i := range budgets {
+		budget := &budgets[i]
+		switch {
+		case budget.CategoryID != nil:
+			tally(byCategory, *budget.CategoryID).setBudget(budget)
+		case budget.BirthdayID != nil:
+			if byID[*budget.BirthdayID] != nil {
+				tally(byPerson, *budget.BirthdayID).setBudget(budget)
+			}
+		default:
+			totals.setBudget(budget)
+		}
+	}
+
+	report := &models.SpendingReportResponse{
+		Year:       year,
+		Totals:     totals.list(),
+		ByMonth:    []*models.MonthSpending{},
+		ByCategory: []*models.CategorySpending{},
+		ByPerson:   []*models.PersonSpending{},
+	}
+	for month := 1; month <= 12; month++ {
+		if months[month] != nil {
+			report.ByMonth = append(report.ByMonth, &models.MonthSpending{Month: month, Amounts: months[month].list()})
+		}
+	}
+	for _, category := range categories {
+		if amounts := byCategory[category.ID]; amounts != nil {
+			report.ByCategory = append(report.ByCategory, &models.CategorySpending{
+				CategoryID: category.ID,
+				Category:   category.Name,
+				Amounts:    amounts.list(),
+			})
+		}
+	}
+	for id, amounts := range byPerson {
+		report.ByPerson = append(report.ByPerson, &models.PersonSpending{
+			BirthdayID: id,
+			Name:       byID[id].Name,
+			Amounts:    amounts.list(),
+		})
+	}
+	sort.Slice(report.ByPerson, func(i, j int) bool {
+		a, b := report.ByPerson[i], report.ByPerson[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.BirthdayID.String() < b.BirthdayID.String()
+	})
+
+	return report, nil
+}
+
+// giftOccasion returns the occurrence of birthday a gift is for: the one in
+// its given_year for given gifts, and the next one otherwise. A Feb 29
+// birthday skipped in the given year falls on Mar 1.
+func giftOccasion(birthday *models.Birthday, gift *models.Gift, cal models.Calendar) time.Time {
+	if gift.IsOpen() || gift.GivenYear == nil {
+		return birthday.NextOccurrence(cal)
+	}
+	date, ok := birthday.ObservedDate(*gift.GivenYear, cal.LeapDayPolicy)
+	if !ok {
+		date, _ = birthday.ObservedDate(*gift.GivenYear, models.LeapDayMar1)
+	}
+	return date
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/murathanje/birthday_tracking_backend/internal/models"
+	"github.com/murathanje/birthday_tracking_backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidTag = errors.New("tags must not be empty or contain commas")
+	ErrTagTooLong = errors.New("tags must be at most 50 characters")
+)
+
+type TagService struct {
+	repo *repository.TagRepository
+}
+
+func NewTagService(repo *repository.TagRepository) *TagService {
+	return &TagService{repo: repo}
+}
+
+// withTx returns a service whose repository runs in tx
+func (s *TagService) withTx(tx *gorm.DB) *TagService {
+	return &TagService{repo: s.repo.WithTx(tx)}
+}
+
+func (s *TagService) GetByID(id uuid.UUID) (*models.Tag, error) {
+	return s.repo.GetByID(id)
+}
+
+func (s *TagService) GetByUserID(userID uuid.UUID) ([]models.TagResponse, error) {
+	return s.repo.GetByUserIDWithCounts(userID)
+}
+
+func (s *TagService) Delete(id uuid.UUID) error {
+	return s.repo.Delete(id)
+}
+
+// NormalizeTags validates names and returns their canonical forms with
+// duplicates removed, preserving order.
+func NormalizeTags(names []string) ([]string, error) {
+	seen := make(map[string]bool, len(names))
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		tag := models.NormalizeTagName(name)
+		if tag == "" || strings.Contains(tag, ",") {
+			return nil, ErrInvalidTag
+		}
+		if utf8.RuneCountInString(tag) > 50 {
+			return nil, ErrTagTooLong
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized, nil
+}
+
+// FindOrCreate returns the user's tags with the given names, creating the
+// ones that do not exist yet.
+func (s *TagService) FindOrCreate(userID uuid.UUID, names []string) ([]models.Tag, error) {
+	normalized, err := NormalizeTags(names)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]models.Tag, 0, len(normalized))
+	for _, name := range normalized {
+		if tag, err := s.repo.GetByName(userID, name); err == nil {
+			tags = append(tags, *tag)
+			continue
+		}
+
+		tag := models.Tag{UserID: userID, Name: name}
+		created, err := s.repo.CreateIfNotExists(&tag)
+		if err != nil {
+			return nil, err
+		}
+		if !created {
+			// Another request created it concurrently.
+			existing, err := s.repo.GetByName(userID, name)
+			if err != nil {
+				return nil, err
+			}
+			tag = *existing
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   []string
+		want    []string
+		wantErr error
+	}{
+		{name: "none", names: nil, want: []string{}},
+		{name: "normalized and deduplicated", names: []string{" Close ", "close", "Work"}, want: []string{"close", "work"}},
+		{name: "50 characters", names: []string{strings.Repeat("t", 50)}, want: []string{strings.Repeat("t", 50)}},
+		{name: "50 multibyte characters", names: []string{strings.Repeat("ß", 50)}, want: []string{strings.Repeat("ß", 50)}},
+		{name: "empty", names: []string{"  "}, wantErr: ErrInvalidTag},
+		{name: "comma", names: []string{"a,b"}, wantErr: ErrInvalidTag},
+		{name: "too long", names: []string{strings.Repeat("t", 51)}, wantErr: ErrTagTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeTags(tt.names)
+			if err != tt.wantErr {
+				t.Fatalf("NormalizeTags(%q) error = %v, want %v", tt.names, err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NormalizeTags(%q) = %q, want %q", tt.names, got, tt.want)
+			}
+		})
+	}
+}
@@ -14,7 +14,9 @@ import (
 
 var (
 	ErrInvalidCredentials = errors.New("invalid email or password")
-	tokenExpiration      = 24 * time.Hour
+	ErrInvalidTimezone    = errors.New("invalid timezone")
+	ErrInvalidLeapDay     = errors.New("invalid leap day policy, expected feb28, mar1 or skip")
+	tokenExpiration       = 24 * time.Hour
 )
 
 type UserService struct {
@@ -30,15 +32,33 @@ func NewUserService(repo *repository.UserRepository, cfg *config.Config) *UserSe
 }
 
 func (s *UserService) CreateUser(req *models.CreateUserRequest) (*models.User, error) {
+	timezone := "UTC"
+	if req.Timezone != "" {
+		if _, err := time.LoadLocation(req.Timezone); err != nil {
+			return nil, ErrInvalidTimezone
+		}
+		timezone = req.Timezone
+	}
+
+	leapDayPolicy := models.LeapDayMar1
+	if req.LeapDayPolicy != "" {
+		if !req.LeapDayPolicy.IsValid() {
+			return nil, ErrInvalidLeapDay
+		}
+		leapDayPolicy = req.LeapDayPolicy
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, err
 	}
 
 	user := &models.User{
-		Name:         req.Name,
-		Email:        req.Email,
-		PasswordHash: string(hashedPassword),
+		Name:          req.Name,
+		Email:         req.Email,
+		PasswordHash:  string(hashedPassword),
+		Timezone:      timezone,
+		LeapDayPolicy: leapDayPolicy,
 	}
 
 	if err := s.repo.Create(user); err != nil {
@@ -69,6 +89,20 @@ func (s *UserService) UpdateUser(id uuid.UUID, req *models.UpdateUserRequest) (*
 		}
 	}
 
+	if req.Timezone != "" {
+		if _, err := time.LoadLocation(req.Timezone); err != nil {
+			return nil, ErrInvalidTimezone
+		}
+		user.Timezone = req.Timezone
+	}
+
+	if req.LeapDayPolicy != "" {
+		if !req.LeapDayPolicy.IsValid() {
+			return nil, ErrInvalidLeapDay
+		}
+		user.LeapDayPolicy = req.LeapDayPolicy
+	}
+
 	user.Name = req.Name
 	user.Email = req.Email
 
@@ -127,4 +161,4 @@ func (s *UserService) Login(req *models.LoginRequest) (*models.LoginResponse, er
 
 func (s *UserService) GetJWTSecret() []byte {
 	return []byte(s.config.JWTSecret)
-} 
\ No newline at end of file
+}
@@ -0,0 +1,40 @@
+// Package storage keeps binary objects, such as photos, in a local directory
+// or an S3-compatible bucket.
+package storage
+
+import (
+	"errors"
+	"io"
+	"strings"
+)
+
+var (
+	ErrNotFound   = errors.New("object not found")
+	ErrInvalidKey = errors.New("invalid object key")
+)
+
+// Storage stores objects under slash-separated keys
+type Storage interface {
+	// Put stores data under key, replacing any existing object
+	Put(key string, data []byte, contentType string) error
+	// Get opens the object stored under key. It returns ErrNotFound if
+	// there is none.
+	Get(key string) (io.ReadCloser, error)
+	// Delete removes the object stored under key. Deleting a missing
+	// object is not an error.
+	Delete(key string) error
+}
+
+// validKey reports whether key is a relative slash-separated path without
+// empty, "." or ".." segments.
+func validKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." || strings.ContainsAny(segment, "\\\x00") {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,128 @@
+// Package vcard reads the parts of vCard 3.0 and 4.0 (RFC 2426, RFC 6350)
+// files needed to import birthdays.
+package vcard
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/murathanje/birthday_tracking_backend/internal/contentline"
+)
+
+// ErrInvalid is returned for files that are not vCards
+var ErrInvalid = errors.New("not a vCard file")
+
+// Card is a contact read from a vCard file
+type Card struct {
+	// Position is the 1-based position of the card in the file
+	Position int
+	// Name is the formatted name (FN), or one built from N when FN is missing
+	Name string
+	// Birthday is the raw BDAY value; empty when the card has none
+	Birthday string
+	// OmitYear is the year Apple clients put into BDAY when the year is unknown
+	OmitYear string
+	// Categories are the values of the CATEGORIES properties
+	Categories []string
+}
+
+// Parse reads every card in r. Lines outside BEGIN:VCARD and END:VCARD are
+// ignored.
+func Parse(r io.Reader) ([]Card, error) {
+	properties, err := contentline.Read(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	var cards []Card
+	var card *Card
+	var structuredName string
+	for _, prop := range properties {
+		switch {
+		case prop.Name == "BEGIN" && strings.EqualFold(prop.Value, "VCARD"):
+			card = &Card{Position: len(cards) + 1}
+			structuredName = ""
+		case card == nil:
+			continue
+		case prop.Name == "END" && strings.EqualFold(prop.Value, "VCARD"):
+			if card.Name == "" {
+				card.Name = structuredName
+			}
+			cards = append(cards, *card)
+			card = nil
+		case prop.Name == "FN":
+			card.Name = strings.TrimSpace(contentline.Unescape(prop.Value))
+		case prop.Name == "N":
+			structuredName = nameFromN(prop.Value)
+		case prop.Name == "BDAY":
+			card.Birthday = strings.TrimSpace(prop.Value)
+			card.OmitYear = prop.Params["X-APPLE-OMIT-YEAR"]
+		case prop.Name == "CATEGORIES":
+			for _, category := range contentline.Split(prop.Value, ',') {
+				if category = strings.TrimSpace(category); category != "" {
+					card.Categories = append(card.Categories, category)
+				}
+			}
+		}
+	}
+
+	if len(cards) == 0 {
+		return nil, ErrInvalid
+	}
+	return cards, nil
+}
+
+var (
+	dateBasic    = regexp.MustCompile(`^(\d{4})(\d{2})(\d{2})$`)
+	dateExtended = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`)
+	dateNoYear   = regexp.MustCompile(`^--(\d{2})-?(\d{2})$`)
+)
+
+// BirthDate converts the card's BDAY to "YYYY-MM-DD", or "MM-DD" when the
+// year is unknown. Times after the date are ignored.
+func (c *Card) BirthDate() (string, error) {
+	value := c.Birthday
+	if i := strings.IndexByte(value, 'T'); i > 0 {
+		value = value[:i]
+	}
+
+	if m := dateNoYear.FindStringSubmatch(value); m != nil {
+		return m[1] + "-" + m[2], nil
+	}
+
+	m := dateBasic.FindStringSubmatch(value)
+	if m == nil {
+		m = dateExtended.FindStringSubmatch(value)
+	}
+	if m == nil {
+		return "", fmt.Errorf("unsupported BDAY %q", c.Birthday)
+	}
+	if c.OmitYear != "" && c.OmitYear == m[1] {
+		return m[2] + "-" + m[3], nil
+	}
+	if year, _ := strconv.Atoi(m[1]); year == 0 || year == 1604 {
+		// Some clients write placeholder years for birthdays without one.
+		return m[2] + "-" + m[3], nil
+	}
+	return m[1] + "-" + m[2] + "-" + m[3], nil
+}
+
+// nameFromN builds "Prefix Given Additional Family Suffix" from a structured
+// N value
+func nameFromN(value string) string {
+	components := contentline.Split(value, ';')
+	order := []int{3, 1, 2, 0, 4}
+	var parts []string
+	for _, i := range order {
+		if i < len(components) {
+			if part := strings.TrimSpace(components[i]); part != "" {
+				parts = append(parts, part)
+			}
+		}
+	}
+	return strings.Join(parts, " ")
+}
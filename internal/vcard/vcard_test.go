@@ -0,0 +1,118 @@
+package vcard
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	input := strings.Join([]string{
+		"BEGIN:VCARD",
+		"VERSION:3.0",
+		"FN:Jane Doe",
+		"N:Doe;Jane;;;",
+		"BDAY:1985-03-15",
+		`CATEGORIES:Friends\, close,Work,,`,
+		"END:VCARD",
+		"BEGIN:VCARD",
+		"VERSION:4.0",
+		"N:Doe;John;Michael;Dr.;Jr.",
+		`item1.BDAY;X-APPLE-OMIT-YEAR=1604:1604-07-01`,
+		"END:VCARD",
+		"BEGIN:VCARD",
+		"FN:Max",
+		" imilian Muster",
+		"NOTE:lines without a colon are skipped",
+		"not a property",
+		"END:VCARD",
+		"",
+	}, "\r\n")
+
+	cards, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	want := []Card{
+		{Position: 1, Name: "Jane Doe", Birthday: "1985-03-15", Categories: []string{"Friends, close", "Work"}},
+		{Position: 2, Name: "Dr. John Michael Doe Jr.", Birthday: "1604-07-01", OmitYear: "1604"},
+		{Position: 3, Name: "Maximilian Muster"},
+	}
+	if !reflect.DeepEqual(cards, want) {
+		t.Errorf("Parse() = %+v, want %+v", cards, want)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, input := range []string{"", "FN:Jane Doe\n", "BEGIN:VCALENDAR\nEND:VCALENDAR\n"} {
+		if _, err := Parse(strings.NewReader(input)); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Parse(%q) error = %v, want ErrInvalid", input, err)
+		}
+	}
+}
+
+func TestBirthDate(t *testing.T) {
+	tests := []struct {
+		birthday string
+		omitYear string
+		want     string
+		wantErr  bool
+	}{
+		{birthday: "19850315", want: "1985-03-15"},
+		{birthday: "1985-03-15", want: "1985-03-15"},
+		{birthday: "1985-03-15T00:00:00Z", want: "1985-03-15"},
+		{birthday: "19850315T120000", want: "1985-03-15"},
+		{birthday: "--0315", want: "03-15"},
+		{birthday: "--03-15", want: "03-15"},
+		{birthday: "1604-03-15", want: "03-15"},
+		{birthday: "16040315", want: "03-15"},
+		{birthday: "0000-03-15", want: "03-15"},
+		{birthday: "1900-03-15", omitYear: "1900", want: "03-15"},
+		{birthday: "1985-03-15", omitYear: "1604", want: "1985-03-15"},
+		{birthday: "March 15", wantErr: true},
+		{birthday: "1985-3-15", wantErr: true},
+		{birthday: "--3-15", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.birthday+"/"+tt.omitYear, func(t *testing.T) {
+			card := &Card{Birthday: tt.birthday, OmitYear: tt.omitYear}
+			got, err := card.BirthDate()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("BirthDate() = %q, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BirthDate() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("BirthDate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameFromN(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "Doe;Jane;;;", want: "Jane Doe"},
+		{value: "Doe;John;Michael;Dr.;Jr.", want: "Dr. John Michael Doe Jr."},
+		{value: `O\;Neill;Sean`, want: "Sean O;Neill"},
+		{value: "Doe", want: "Doe"},
+		{value: ";;;;", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := nameFromN(tt.value); got != tt.want {
+				t.Errorf("nameFromN(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}